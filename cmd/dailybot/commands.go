@@ -0,0 +1,417 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/arxiv"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/history"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/openreview"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/readinglist"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/scheduler"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/selector"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/venueselector"
+)
+
+// parseFlags はフラグを解析して環境変数に反映し、位置引数の数を検証します。
+func parseFlags(fs *flag.FlagSet, args []string, nargs int, defs ...[]envFlag) ([]string, error) {
+	apply := bindEnvFlags(fs, defs...)
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+	if fs.NArg() != nargs {
+		fs.Usage()
+		return nil, fmt.Errorf("%s: expected %d argument(s), got %d", fs.Name(), nargs, fs.NArg())
+	}
+	apply()
+	return fs.Args(), nil
+}
+
+// loadConfig は設定を読み込みます。
+func loadConfig() (*config.Config, error) {
+	log.Println("INFO: Loading configuration...")
+	cfg, err := config.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := checkConfig(cfg); err != nil {
+		return nil, fmt.Errorf("failed to load config: %w", err)
+	}
+	return cfg, nil
+}
+
+// checkConfig は config パッケージが参照できない、各パッケージの登録内容に依存する設定を検証します。
+func checkConfig(cfg *config.Config) error {
+	if !venueselector.IsRegistered(cfg.VenueSelectStrategy) {
+		return fmt.Errorf("invalid VENUE_SELECT_STRATEGY: %s. must be one of %v", cfg.VenueSelectStrategy, venueselector.Strategies())
+	}
+	return nil
+}
+
+// runCommand は学会と論文を選定して1回投稿します。
+func runCommand(ctx context.Context, fs *flag.FlagSet, args []string) error {
+	if _, err := parseFlags(fs, args, 0, pipelineFlags, requestFlags); err != nil {
+		return err
+	}
+	if err := run(ctx); err != nil {
+		return err
+	}
+	log.Println("INFO: Process completed successfully.")
+	return nil
+}
+
+// run はパイプラインを1回実行します。serve からも呼び出されるため、設定は毎回読み直します。
+func run(ctx context.Context) error {
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+
+	// 実行全体のタイムアウトを設定
+	ctx, cancel := context.WithTimeout(ctx, cfg.RunTimeout)
+	defer cancel()
+	log.Printf("INFO: Run timeout set to %s.", cfg.RunTimeout)
+
+	p, err := newPipeline(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	if cfg.PaperID != "" {
+		log.Printf("INFO: Paper requested (PAPER_ID=%s). Skipping venue and paper selection.", cfg.PaperID)
+		return postPaper(ctx, p, cfg.PaperID, "", false)
+	}
+
+	venueSelector, err := p.newVenueSelector(ctx)
+	if err != nil {
+		return err
+	}
+
+	venue, note, err := p.selectVenueAndPaper(ctx, venueSelector)
+	if errors.Is(err, selector.ErrNoCandidates) {
+		log.Println("INFO: Nothing to post.")
+		return nil // 候補なしは正常終了
+	}
+	if err != nil {
+		return err
+	}
+
+	return p.publish(ctx, note, venue, func() error {
+		committer, ok := venueSelector.(venueselector.Committer)
+		if !ok {
+			return nil
+		}
+		if err := committer.Commit(venue); err != nil {
+			return fmt.Errorf("failed to save venue cursor: %w", err)
+		}
+		log.Printf("INFO: Saved venue cursor (%s).", cfg.VenueCursorPath)
+		return nil
+	})
+}
+
+// serveCommand は常駐プロセスとして SCHEDULE_CRON に従って run を繰り返し実行します。
+// 各回の失敗はログに出力して次回の実行を待ちます。設定は実行ごとに読み直されます。
+func serveCommand(ctx context.Context, fs *flag.FlagSet, args []string) error {
+	if _, err := parseFlags(fs, args, 0, pipelineFlags, scheduleFlags); err != nil {
+		return err
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	if cfg.PaperID != "" {
+		return fmt.Errorf("PAPER_ID cannot be used with serve. use 'dailybot post %s' instead", cfg.PaperID)
+	}
+
+	s, err := newScheduler(cfg)
+	if err != nil {
+		return err
+	}
+	log.Printf("INFO: Starting daemon mode (schedule: %q, timezone: %s).", cfg.ScheduleCron, cfg.ScheduleLocation)
+	return s.Run(ctx)
+}
+
+// newScheduler は SCHEDULE_CRON と SCHEDULE_TIMEZONE に従って run を実行する Scheduler を生成します。
+func newScheduler(cfg *config.Config) (*scheduler.Scheduler, error) {
+	s, err := scheduler.New(cfg.ScheduleCron, cfg.ScheduleLocation, run)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SCHEDULE_CRON: %w", err)
+	}
+	return s, nil
+}
+
+// fetchPaper は論文IDで論文を取得し、投稿に使う学会設定を決定します。
+// 論文リストの ID ("bibtex:<path>#<key>" など) は venues.json の論文リスト (type が bibtex / csv) から、それ以外は arXiv ID か OpenReview の論文IDとして取得します。
+// 学会は venueOverride (未指定なら論文の venueid) に一致するものを venues.json から探し、
+// 見つからなければ Venue ID から組み立てます。
+func fetchPaper(ctx context.Context, p *pipeline, paperID, venueOverride string) (*paper.Paper, config.VenueConfig, error) {
+	if readinglist.IsPaperID(paperID) {
+		paper, err := p.readingList.GetPaper(ctx, paperID)
+		if err != nil {
+			return nil, config.VenueConfig{}, err
+		}
+		venue, err := resolveVenue(p.cfg.Venues, paper, venueOverride)
+		if err != nil {
+			return nil, config.VenueConfig{}, err
+		}
+		return paper, venue, nil
+	}
+
+	if arxivID, ok := arxiv.ParseID(paperID); ok {
+		return fetchArXivPaper(ctx, p, arxivID, venueOverride)
+	}
+
+	paperID = openreview.ParseNoteID(paperID)
+	log.Printf("INFO: Fetching paper %s from OpenReview...", paperID)
+	paper, err := p.orClient.GetPaper(ctx, paperID)
+	if errors.Is(err, openreview.ErrNoteNotFound) {
+		return nil, config.VenueConfig{}, fmt.Errorf("%w. check the ID (the 'id' parameter of the forum URL); non-public papers require OR_EMAIL and OR_PASSWORD", err)
+	}
+	if err != nil {
+		return nil, config.VenueConfig{}, err
+	}
+
+	venue, err := resolveVenue(p.cfg.Venues, paper, venueOverride)
+	if err != nil {
+		return nil, config.VenueConfig{}, err
+	}
+	log.Printf("INFO: Paper %s belongs to %s %d.", paper.ID, venue.Name, venue.Year)
+	return paper, venue, nil
+}
+
+// fetchArXivPaper は arXiv から論文を取得し、投稿に使う学会設定とともに返します。
+func fetchArXivPaper(ctx context.Context, p *pipeline, arxivID, venueOverride string) (*paper.Paper, config.VenueConfig, error) {
+	log.Printf("INFO: Fetching paper %s from arXiv...", arxivID)
+	paper, err := p.arxivClient.GetPaper(ctx, arxivID)
+	if err != nil {
+		return nil, config.VenueConfig{}, err
+	}
+
+	venue := resolveArXivVenue(p.cfg.Venues, paper, venueOverride)
+	log.Printf("INFO: Paper %s is posted as %s.", paper.ID, venue.Name)
+	return paper, venue, nil
+}
+
+// resolveArXivVenue は arXiv の論文を投稿する際の学会設定を決定します。
+// venueOverride に一致する設定、主カテゴリを検索条件に含む type=arxiv の設定の順に探し、なければ主カテゴリから作ります。
+func resolveArXivVenue(venues []config.VenueConfig, paper *paper.Paper, venueOverride string) config.VenueConfig {
+	category := paper.PrimaryArea
+	for _, v := range venues {
+		if venueOverride != "" && (v.Venue == venueOverride || v.Name == venueOverride) {
+			return v
+		}
+		if venueOverride == "" && v.Type == config.SourceArXiv && strings.Contains(v.Venue, "cat:"+category) {
+			return v
+		}
+	}
+	query := venueOverride
+	if query == "" {
+		query = "cat:" + category
+	}
+	return config.VenueConfig{
+		Name:   "arXiv",
+		Venue:  query,
+		Year:   paper.Published.Year(),
+		Status: "all",
+		Type:   config.SourceArXiv,
+	}
+}
+
+// resolveVenue は論文を投稿する際の学会設定を決定します。venues.json にあればその設定 (色など) を使います。
+func resolveVenue(venues []config.VenueConfig, paper *paper.Paper, venueOverride string) (config.VenueConfig, error) {
+	venueID := venueOverride
+	if venueID == "" {
+		venueID = paper.VenueID
+	}
+	if venueID == "" {
+		return config.VenueConfig{}, fmt.Errorf("paper %s has no venueid. specify one with -venue", paper.ID)
+	}
+
+	derived, derr := config.VenueFromID(venueID)
+	for _, v := range venues {
+		if v.Venue == venueID || v.Name == venueID || (derr == nil && v.Venue == derived.Venue) {
+			return v, nil
+		}
+	}
+	if derr != nil {
+		return config.VenueConfig{}, fmt.Errorf("%w. specify a venue ID with -venue", derr)
+	}
+	log.Printf("INFO: Venue %s is not in venues.json. Derived it from the venueid.", derived.Venue)
+	return derived, nil
+}
+
+// postPaper は指定した論文を選定処理を経ずに翻訳・整形して投稿します。投稿済みの論文は force でない限り投稿しません。
+func postPaper(ctx context.Context, p *pipeline, paperID, venueOverride string, force bool) error {
+	paper, venue, err := fetchPaper(ctx, p, paperID, venueOverride)
+	if err != nil {
+		return err
+	}
+	if p.history.Contains(paper.ID) && !force {
+		return fmt.Errorf("paper %s has already been posted. use -force to post it again", paper.ID)
+	}
+	return p.publish(ctx, paper, venue, nil)
+}
+
+// previewCommand は指定した論文を投稿先ごとに整形して標準出力に表示します。投稿も履歴の記録もしません。
+func previewCommand(ctx context.Context, fs *flag.FlagSet, args []string) error {
+	venueOverride := fs.String("venue", "", "venue ID, or name in venues.json, to format the paper with (default: the paper's venueid)")
+	args, err := parseFlags(fs, args, 1, pipelineFlags)
+	if err != nil {
+		return err
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.RunTimeout)
+	defer cancel()
+
+	p, err := newPipeline(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	paper, venue, err := fetchPaper(ctx, p, args[0], *venueOverride)
+	if err != nil {
+		return err
+	}
+	jaAbstract, err := p.prepare(ctx, paper)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range p.targets {
+		msg := target.Formatter.Format(paper, venue, cfg.AbstractMaxChars, jaAbstract)
+		fmt.Print(describeMessage(target.Platform, msg))
+	}
+	if p.history.Contains(paper.ID) {
+		log.Printf("INFO: Paper %s has already been posted.", paper.ID)
+	}
+	return nil
+}
+
+// postCommand は指定した論文を選定処理を経ずに投稿します。投稿済みの論文は -force を指定しない限り投稿しません。
+func postCommand(ctx context.Context, fs *flag.FlagSet, args []string) error {
+	venueOverride := fs.String("venue", "", "venue ID, or name in venues.json, to post the paper as (default: the paper's venueid)")
+	force := fs.Bool("force", false, "post even if the paper has already been posted")
+	args, err := parseFlags(fs, args, 1, pipelineFlags)
+	if err != nil {
+		return err
+	}
+	cfg, err := loadConfig()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, cfg.RunTimeout)
+	defer cancel()
+
+	p, err := newPipeline(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	if err := postPaper(ctx, p, args[0], *venueOverride, *force); err != nil {
+		return err
+	}
+	log.Println("INFO: Process completed successfully.")
+	return nil
+}
+
+// listVenuesCommand は assets/venues.json の学会を一覧表示します。
+func listVenuesCommand(_ context.Context, fs *flag.FlagSet, args []string) error {
+	if _, err := parseFlags(fs, args, 0); err != nil {
+		return err
+	}
+	venues, err := config.LoadVenues()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tTYPE\tVENUE\tYEAR\tSTATUS\tWEIGHT\tCOLOR")
+	for _, v := range venues {
+		weight := "1"
+		if v.Weight > 0 {
+			weight = fmt.Sprint(v.Weight)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%s\t%s\t%s\n", v.Name, v.Type, v.Venue, v.Year, v.Status, weight, v.Color)
+	}
+	return w.Flush()
+}
+
+// validateConfigCommand は設定を読み込み、投稿先・テンプレート・選定戦略まで含めて検証します。
+func validateConfigCommand(_ context.Context, fs *flag.FlagSet, args []string) error {
+	if _, err := parseFlags(fs, args, 0, pipelineFlags, scheduleFlags); err != nil {
+		return err
+	}
+	cfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	for _, platform := range cfg.TargetPlatforms {
+		if _, err := newTarget(cfg, platform, retryPolicyFromConfig(cfg)); err != nil {
+			return fmt.Errorf("invalid config for %s: %w", platform, err)
+		}
+	}
+	if err := checkConfig(cfg); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	if _, err := loadInterestProfile(cfg.InterestProfilePath); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+	if _, err := newScheduler(cfg); err != nil {
+		return fmt.Errorf("invalid config: %w", err)
+	}
+
+	fmt.Printf("Configuration is valid.\n")
+	fmt.Printf("  venues: %d\n", len(cfg.Venues))
+	fmt.Printf("  targets: %v (failure policy: %s)\n", cfg.TargetPlatforms, cfg.PostFailurePolicy)
+	fmt.Printf("  venue strategy: %s, paper strategy: %s\n", cfg.VenueSelectStrategy, cfg.SelectStrategy)
+	fmt.Printf("  translation: %t (provider: %s), reviews: %t, dry run: %t\n", cfg.TranslateEnabled, strings.Join(cfg.TranslatorProviders, " > "), cfg.ShowReviews, cfg.DryRun)
+	fmt.Printf("  schedule: %q (%s)\n", cfg.ScheduleCron, cfg.ScheduleLocation)
+	return nil
+}
+
+// historyCommand は投稿済みの論文を新しい順に表示します。
+func historyCommand(_ context.Context, fs *flag.FlagSet, args []string) error {
+	limit := fs.Int("limit", 20, "maximum number of entries to show (0 = all)")
+	if _, err := parseFlags(fs, args, 0, []envFlag{{name: "history", env: "HISTORY_PATH", usage: "path to the posted history file"}}); err != nil {
+		return err
+	}
+
+	store := history.NewJSONStore(config.HistoryPath())
+	if err := store.Load(); err != nil {
+		return err
+	}
+
+	type row struct {
+		id    string
+		entry history.Entry
+	}
+	var rows []row
+	for id, e := range store.Entries() {
+		rows = append(rows, row{id: id, entry: e})
+	}
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].entry.Date != rows[j].entry.Date {
+			return rows[i].entry.Date > rows[j].entry.Date
+		}
+		return rows[i].id < rows[j].id
+	})
+	if *limit > 0 && len(rows) > *limit {
+		rows = rows[:*limit]
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DATE\tPAPER ID\tVENUE\tPLATFORM")
+	for _, r := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.entry.Date, r.id, r.entry.Venue, r.entry.Platform)
+	}
+	return w.Flush()
+}
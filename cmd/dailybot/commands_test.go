@@ -0,0 +1,203 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/formatter"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/venueselector"
+)
+
+func TestResolveVenue(t *testing.T) {
+	venues := []config.VenueConfig{
+		{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025, Status: "accepted", Color: "#123456"},
+	}
+	paperWithVenueID := func(venueID string) *paper.Paper {
+		return &paper.Paper{ID: "p1", VenueID: venueID}
+	}
+
+	t.Run("uses the venue in venues.json", func(t *testing.T) {
+		got, err := resolveVenue(venues, paperWithVenueID("ICLR.cc/2025/Conference"), "")
+		if err != nil {
+			t.Fatalf("resolveVenue() failed: %v", err)
+		}
+		if got.Color != "#123456" {
+			t.Errorf("expected venue from venues.json, got %+v", got)
+		}
+	})
+
+	t.Run("rejected submission matches its conference", func(t *testing.T) {
+		got, err := resolveVenue(venues, paperWithVenueID("ICLR.cc/2025/Conference/Rejected_Submission"), "")
+		if err != nil {
+			t.Fatalf("resolveVenue() failed: %v", err)
+		}
+		if got.Color != "#123456" {
+			t.Errorf("expected venue from venues.json, got %+v", got)
+		}
+	})
+
+	t.Run("derives a venue not in venues.json", func(t *testing.T) {
+		got, err := resolveVenue(venues, paperWithVenueID("NeurIPS.cc/2024/Conference"), "")
+		if err != nil {
+			t.Fatalf("resolveVenue() failed: %v", err)
+		}
+		if got.Name != "NeurIPS" || got.Year != 2024 || got.Venue != "NeurIPS.cc/2024/Conference" {
+			t.Errorf("unexpected derived venue: %+v", got)
+		}
+	})
+
+	t.Run("override by name", func(t *testing.T) {
+		got, err := resolveVenue(venues, paperWithVenueID(""), "ICLR")
+		if err != nil {
+			t.Fatalf("resolveVenue() failed: %v", err)
+		}
+		if got.Venue != "ICLR.cc/2025/Conference" {
+			t.Errorf("unexpected venue: %+v", got)
+		}
+	})
+
+	t.Run("missing venueid fails", func(t *testing.T) {
+		if _, err := resolveVenue(venues, paperWithVenueID(""), ""); err == nil {
+			t.Error("expected error for note without venueid")
+		}
+	})
+}
+
+func TestResolveArXivVenue(t *testing.T) {
+	venues := []config.VenueConfig{
+		{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025, Type: config.SourceOpenReview},
+		{Name: "arXiv cs.CL", Venue: "cat:cs.CL", Type: config.SourceArXiv, Color: "#b31b1b"},
+	}
+	arxivPaper := &paper.Paper{ID: "2401.01234", Source: config.SourceArXiv, PrimaryArea: "cs.CL", Published: time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)}
+
+	t.Run("matches the venue by primary category", func(t *testing.T) {
+		if got := resolveArXivVenue(venues, arxivPaper, ""); got.Color != "#b31b1b" {
+			t.Errorf("expected venue from venues.json, got %+v", got)
+		}
+	})
+
+	t.Run("override by name", func(t *testing.T) {
+		if got := resolveArXivVenue(venues, arxivPaper, "ICLR"); got.Venue != "ICLR.cc/2025/Conference" {
+			t.Errorf("unexpected venue: %+v", got)
+		}
+	})
+
+	t.Run("derives a venue from the category", func(t *testing.T) {
+		other := *arxivPaper
+		other.PrimaryArea = "cs.CV"
+		got := resolveArXivVenue(venues, &other, "")
+		if got.Name != "arXiv" || got.Venue != "cat:cs.CV" || got.Year != 2024 || got.Type != config.SourceArXiv {
+			t.Errorf("unexpected derived venue: %+v", got)
+		}
+	})
+}
+
+func TestDescribeMessage(t *testing.T) {
+	venue := config.VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025}
+	p := &paper.Paper{ID: "PID", Title: "A Great Paper", Authors: []string{"Alice"}, Abstract: "Abstract.", URL: "https://openreview.net/forum?id=PID"}
+
+	t.Run("slack shows the posted blocks", func(t *testing.T) {
+		got := describeMessage("slack", formatter.NewSlackFormatter().Format(p, venue, 0, ""))
+		for _, want := range []string{"=== slack: Blocks ===", `"type": "header"`, "=== slack: Main (notification text) ==="} {
+			if !strings.Contains(got, want) {
+				t.Errorf("expected %q in preview:\n%s", want, got)
+			}
+		}
+	})
+
+	t.Run("discord shows the posted embed instead of Main", func(t *testing.T) {
+		got := describeMessage("discord", formatter.NewDiscordFormatter().Format(p, venue, 0, "訳"))
+		for _, want := range []string{"=== discord: Embeds ===", `"title": "A Great Paper"`, "=== discord: Sub ==="} {
+			if !strings.Contains(got, want) {
+				t.Errorf("expected %q in preview:\n%s", want, got)
+			}
+		}
+		if strings.Contains(got, "discord: Main") {
+			t.Errorf("did not expect Main, which is not posted with embeds:\n%s", got)
+		}
+	})
+
+	t.Run("text style shows Main", func(t *testing.T) {
+		got := describeMessage("discord", formatter.TextOnly(formatter.NewDiscordFormatter()).Format(p, venue, 0, ""))
+		if !strings.HasPrefix(got, "=== discord: Main ===\n") || strings.Contains(got, "Embeds") {
+			t.Errorf("expected only the Main text:\n%s", got)
+		}
+	})
+}
+
+func TestCheckConfig(t *testing.T) {
+	t.Run("registered venue strategies are accepted", func(t *testing.T) {
+		for _, strategy := range venueselector.Strategies() {
+			if err := checkConfig(&config.Config{VenueSelectStrategy: strategy}); err != nil {
+				t.Errorf("expected %s to be valid, got %v", strategy, err)
+			}
+		}
+	})
+
+	t.Run("unknown venue strategy fails", func(t *testing.T) {
+		err := checkConfig(&config.Config{VenueSelectStrategy: "largest"})
+		if err == nil || !strings.Contains(err.Error(), "VENUE_SELECT_STRATEGY") {
+			t.Errorf("expected VENUE_SELECT_STRATEGY error, got %v", err)
+		}
+	})
+}
+
+func TestLoadInterestProfile(t *testing.T) {
+	writeProfile := func(t *testing.T, content string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "interests.json")
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write profile: %v", err)
+		}
+		return path
+	}
+
+	t.Run("disabled without a path", func(t *testing.T) {
+		profile, err := loadInterestProfile("")
+		if err != nil || profile != nil {
+			t.Errorf("expected no profile, got %+v (err=%v)", profile, err)
+		}
+	})
+
+	t.Run("loads the profile", func(t *testing.T) {
+		profile, err := loadInterestProfile(writeProfile(t, `{"include":[{"keyword":"reinforcement learning","weight":2}],"exclude":[{"regex":"\\bsurvey\\b"}],"min_score":1}`))
+		if err != nil {
+			t.Fatalf("loadInterestProfile() failed: %v", err)
+		}
+		if len(profile.Include) != 1 || profile.MinScore == nil || *profile.MinScore != 1 {
+			t.Errorf("unexpected profile: %+v", profile)
+		}
+	})
+
+	t.Run("invalid regex fails", func(t *testing.T) {
+		if _, err := loadInterestProfile(writeProfile(t, `{"include":[{"regex":"("}]}`)); err == nil {
+			t.Error("expected error for invalid regex")
+		}
+	})
+
+	t.Run("missing file fails", func(t *testing.T) {
+		if _, err := loadInterestProfile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+			t.Error("expected error for missing profile")
+		}
+	})
+}
+
+func TestNewScheduler(t *testing.T) {
+	t.Run("valid cron", func(t *testing.T) {
+		if _, err := newScheduler(&config.Config{ScheduleCron: "30 8 * * 1-5", ScheduleLocation: time.UTC}); err != nil {
+			t.Errorf("newScheduler() failed: %v", err)
+		}
+	})
+
+	t.Run("invalid cron fails", func(t *testing.T) {
+		_, err := newScheduler(&config.Config{ScheduleCron: "every morning", ScheduleLocation: time.UTC})
+		if err == nil || !strings.Contains(err.Error(), "SCHEDULE_CRON") {
+			t.Errorf("expected SCHEDULE_CRON error, got %v", err)
+		}
+	})
+}
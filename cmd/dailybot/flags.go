@@ -0,0 +1,72 @@
+package main
+
+import (
+	"flag"
+	"os"
+)
+
+// envFlag は同名の環境変数を上書きするコマンドラインフラグの定義です。
+// フラグが指定された場合のみ環境変数を上書きするため、未指定のフラグは .env や実行環境の値を変えません。
+type envFlag struct {
+	name   string
+	env    string
+	usage  string
+	isBool bool
+}
+
+// envValue は envFlag の値を保持する flag.Value です。
+type envValue struct {
+	value  string
+	isBool bool
+}
+
+func (v *envValue) String() string     { return v.value }
+func (v *envValue) Set(s string) error { v.value = s; return nil }
+func (v *envValue) IsBoolFlag() bool   { return v.isBool }
+
+// 各サブコマンドで共通のフラグです。
+var pipelineFlags = []envFlag{
+	{name: "platform", env: "TARGET_PLATFORM", usage: "target platforms (slack, discord or slack,discord)"},
+	{name: "dry-run", env: "DRY_RUN", usage: "log formatted messages instead of posting", isBool: true},
+	{name: "venue-strategy", env: "VENUE_SELECT_STRATEGY", usage: "venue select strategy (random, weighted, paper-count, round-robin)"},
+	{name: "strategy", env: "SELECT_STRATEGY", usage: "paper select strategy (random, newest, deterministic-daily, score)"},
+	{name: "temperature", env: "SELECT_TEMPERATURE", usage: "temperature of the score strategy (0 = always the top paper)"},
+	{name: "abstract-max-chars", env: "ABSTRACT_MAX_CHARS", usage: "maximum number of abstract characters"},
+	{name: "translate", env: "TRANSLATE_ENABLED", usage: "translate the abstract into Japanese", isBool: true},
+	{name: "reviews", env: "SHOW_REVIEWS", usage: "include the decision and mean review rating", isBool: true},
+	{name: "history", env: "HISTORY_PATH", usage: "path to the posted history file"},
+	{name: "timeout", env: "RUN_TIMEOUT", usage: "timeout for a whole run (e.g. 5m)"},
+}
+
+// run サブコマンドのみで使うフラグです。
+var requestFlags = []envFlag{
+	{name: "paper", env: "PAPER_ID", usage: "post this paper (ID or forum URL) instead of selecting one"},
+}
+
+// serve サブコマンドのみで使うフラグです。
+var scheduleFlags = []envFlag{
+	{name: "schedule", env: "SCHEDULE_CRON", usage: "cron expression for daemon mode (e.g. \"0 9 * * *\")"},
+	{name: "timezone", env: "SCHEDULE_TIMEZONE", usage: "time zone for the cron expression (e.g. Asia/Tokyo)"},
+}
+
+// bindEnvFlags は defs のフラグを fs に登録し、解析後に呼び出すと指定されたフラグの値を環境変数に反映する関数を返します。
+func bindEnvFlags(fs *flag.FlagSet, defs ...[]envFlag) func() {
+	values := map[string]*envValue{}
+	envs := map[string]string{}
+	for _, group := range defs {
+		for _, d := range group {
+			v := &envValue{isBool: d.isBool}
+			fs.Var(v, d.name, d.usage+" (overrides "+d.env+")")
+			values[d.name] = v
+			envs[d.name] = d.env
+		}
+	}
+
+	return func() {
+		fs.Visit(func(f *flag.Flag) {
+			if v, ok := values[f.Name]; ok {
+				os.Setenv(envs[f.Name], v.value)
+			}
+		})
+	}
+}
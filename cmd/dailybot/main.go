@@ -1,141 +1,91 @@
 package main
 
 import (
+	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	_ "time/tzdata" // コンテナなどタイムゾーン情報のない環境でも SCHEDULE_TIMEZONE を解釈できるようにする
 
-	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
-	"github.com/hayashi-yaken/daily-paper-bot/internal/formatter"
-	"github.com/hayashi-yaken/daily-paper-bot/internal/notifier"
-	"github.com/hayashi-yaken/daily-paper-bot/internal/openreview"
-	"github.com/hayashi-yaken/daily-paper-bot/internal/selector"
-	"github.com/hayashi-yaken/daily-paper-bot/internal/translator"
-	"github.com/hayashi-yaken/daily-paper-bot/internal/venueselector"
 	"github.com/joho/godotenv"
 )
 
+// command はサブコマンドの定義です。
+type command struct {
+	name    string
+	args    string // 使い方に表示する位置引数
+	summary string
+	run     func(ctx context.Context, fs *flag.FlagSet, args []string) error
+}
+
+// commands はサブコマンドの一覧です。引数なしで起動した場合は run を実行します。
+var commands = []command{
+	{name: "run", summary: "select a paper and post it once (default)", run: runCommand},
+	{name: "serve", summary: "run on the SCHEDULE_CRON schedule as a long-lived process (alias: daemon)", run: serveCommand},
+	{name: "preview", args: "<paper-id>", summary: "print formatted messages for a paper without posting", run: previewCommand},
+	{name: "post", args: "<paper-id>", summary: "post a specific paper", run: postCommand},
+	{name: "list-venues", summary: "list venues in assets/venues.json", run: listVenuesCommand},
+	{name: "validate-config", summary: "load and validate the configuration", run: validateConfigCommand},
+	{name: "history", summary: "show posted papers", run: historyCommand},
+}
+
 func main() {
 	// .envファイルを読み込む（ファイルが存在しなくてもエラーにはならない）
 	_ = godotenv.Load()
 
-	if err := run(); err != nil {
-		log.Fatalf("FATAL: %v", err)
-	}
-	log.Println("INFO: Process completed successfully.")
-}
-
-func run() error {
-	// 1. 設定を読み込み
-	log.Println("INFO: Loading configuration...")
-	cfg, err := config.Load()
-	if err != nil {
-		return fmt.Errorf("failed to load config: %w", err)
+	// SIGINT / SIGTERM (スケジューラからの停止要求) で実行中のリクエストをキャンセルする
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	err := dispatch(ctx, os.Args[1:])
+	stop()
+	if errors.Is(err, flag.ErrHelp) {
+		return
 	}
-
-	// 2. 実行対象の学会をランダムに選定
-	venueSelector := venueselector.NewRandomVenueSelector()
-	selectedVenue, err := venueSelector.Select(cfg.Venues)
 	if err != nil {
-		return fmt.Errorf("failed to select venue: %w", err)
-	}
-	log.Printf("INFO: Selected venue for this run: %s %d", selectedVenue.Name, selectedVenue.Year)
-
-	// 3. 各コンポーネントを初期化
-	log.Println("INFO: Initializing components...")
-	orClient := openreview.NewClient(cfg.CustomUserAgent)
-	if cfg.OpenReviewEmail != "" && cfg.OpenReviewPassword != "" {
-		if err := orClient.Login(cfg.OpenReviewEmail, cfg.OpenReviewPassword); err != nil {
-			return fmt.Errorf("failed to login to openreview: %w", err)
-		}
-		log.Println("INFO: Authenticated to OpenReview.")
+		log.Fatalf("FATAL: %v", err)
 	}
-	paperSelector := selector.NewRandomSelector()
+}
 
-	var paperNotifier notifier.Notifier
-	var paperFormatter formatter.Formatter
-	switch cfg.TargetPlatform {
-	case "slack":
-		paperNotifier = notifier.NewSlackNotifier(cfg.SlackBotToken, cfg.SlackChannelID)
-		paperFormatter = formatter.NewSlackFormatter()
-		log.Println("INFO: Target platform set to Slack.")
-	case "discord":
-		paperNotifier = notifier.NewDiscordNotifier(cfg.DiscordWebhookURL)
-		paperFormatter = formatter.NewDiscordFormatter()
-		log.Println("INFO: Target platform set to Discord.")
-	default:
-		return fmt.Errorf("invalid target platform: %s", cfg.TargetPlatform)
+// dispatch はサブコマンドを解釈して実行します。サブコマンドを省略した場合は run として扱います。
+func dispatch(ctx context.Context, args []string) error {
+	name := "run"
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
 	}
-
-	// 4. OpenReviewから論文一覧を取得
-	log.Printf("INFO: Fetching papers from OpenReview (Venue: %s)...", selectedVenue.Venue)
-	notes, err := orClient.GetNotes(selectedVenue.Venue)
-	if err != nil {
-		return fmt.Errorf("failed to get notes from openreview: %w", err)
+	if name == "daemon" {
+		name = "serve" // 別名
 	}
-	log.Printf("INFO: Fetched %d papers.", len(notes))
-
-	// 5. 論文を選定
-	papers := make([]selector.Paper, len(notes))
-	for i := range notes {
-		papers[i] = &notes[i] // ポインタを格納
+	if name == "help" {
+		usage()
+		return flag.ErrHelp
 	}
 
-	log.Println("INFO: Selecting a paper...")
-	selectedPaper, err := paperSelector.Select(papers)
-	if err != nil {
-		if errors.Is(err, selector.ErrNoCandidates) {
-			log.Println("INFO: No valid papers found after filtering. Nothing to post.")
-			return nil // 候補なしは正常終了
+	for _, c := range commands {
+		if c.name != name {
+			continue
 		}
-		return fmt.Errorf("failed to select paper: %w", err)
-	}
-	log.Printf("INFO: Selected paper: %s (ID: %s)", selectedPaper.GetTitle(), selectedPaper.GetID())
-
-	// デバッグ用に取得した生のContent情報をログに出力
-	selectedNote, ok := selectedPaper.(*openreview.Note)
-	if !ok {
-		return fmt.Errorf("selected paper is not of type *openreview.Note")
-	}
-	log.Printf("[DEBUG] Raw content from API: %+v", selectedNote.Content)
-
-	// 5.5. アブストラクトの翻訳（任意）
-	var jaAbstract string
-	if cfg.TranslateEnabled {
-		tr := translator.NewAzureTranslator(
-			cfg.AzureTranslatorEndpoint,
-			cfg.AzureTranslatorRegion,
-			cfg.AzureTranslatorKey,
-		)
-		translated, err := tr.Translate(selectedNote.Content.Abstract.Value, "ja")
-		if err != nil {
-			log.Printf("WARN: translation failed, falling back to original abstract only: %v", err)
-		} else {
-			jaAbstract = translated
-			log.Printf("INFO: Translated abstract (len=%d chars).", len([]rune(jaAbstract)))
+		fs := flag.NewFlagSet(c.name, flag.ContinueOnError)
+		fs.Usage = func() {
+			fmt.Fprintf(fs.Output(), "Usage: dailybot %s [flags] %s\n\n%s\n\nFlags:\n", c.name, c.args, c.summary)
+			fs.PrintDefaults()
 		}
-	} else {
-		log.Println("INFO: Translation disabled.")
+		return c.run(ctx, fs, args)
 	}
 
-	// 6. 投稿メッセージを生成
-	message := paperFormatter.Format(selectedNote, selectedVenue, cfg.AbstractMaxChars, jaAbstract)
-
-	// 7. DryRun または 投稿
-	if cfg.DryRun {
-		log.Println("INFO: Dry run mode is enabled. Skipping post.")
-		log.Printf("--- Main ---\n%s\n------------", message.Main)
-		if message.Sub != "" {
-			log.Printf("--- Sub (thread) ---\n%s\n--------------------", message.Sub)
-		}
-		return nil
-	}
+	usage()
+	return fmt.Errorf("unknown command: %s", name)
+}
 
-	log.Printf("INFO: Posting to %s...", cfg.TargetPlatform)
-	if err := paperNotifier.Post(message); err != nil {
-		return fmt.Errorf("failed to post notification: %w", err)
+// usage はサブコマンドの一覧を表示します。
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: dailybot <command> [flags] [args]")
+	fmt.Fprintln(os.Stderr, "\nCommands:")
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-20s %s\n", strings.TrimSpace(c.name+" "+c.args), c.summary)
 	}
-	log.Println("INFO: Post successful.")
-
-	return nil
+	fmt.Fprintln(os.Stderr, "\nRun 'dailybot <command> -h' for the flags of each command. Flags override environment variables.")
 }
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"io"
+	"os"
+	"testing"
+)
+
+func TestBindEnvFlags(t *testing.T) {
+	t.Setenv("TARGET_PLATFORM", "slack")
+	t.Setenv("DRY_RUN", "false")
+	t.Setenv("HISTORY_PATH", "data/posted.json")
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	apply := bindEnvFlags(fs, pipelineFlags)
+	if err := fs.Parse([]string{"-platform", "discord", "-dry-run"}); err != nil {
+		t.Fatalf("Parse() failed: %v", err)
+	}
+	apply()
+
+	if got := os.Getenv("TARGET_PLATFORM"); got != "discord" {
+		t.Errorf("expected TARGET_PLATFORM=discord, got %q", got)
+	}
+	if got := os.Getenv("DRY_RUN"); got != "true" {
+		t.Errorf("expected boolean flag to set DRY_RUN=true, got %q", got)
+	}
+	if got := os.Getenv("HISTORY_PATH"); got != "data/posted.json" {
+		t.Errorf("unset flag must not override HISTORY_PATH, got %q", got)
+	}
+}
+
+func TestParseFlags_ArgumentCount(t *testing.T) {
+	fs := flag.NewFlagSet("preview", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	if _, err := parseFlags(fs, nil, 1, pipelineFlags); err == nil {
+		t.Error("expected error for missing paper id")
+	}
+}
+
+func TestDispatch_UnknownCommand(t *testing.T) {
+	stderr := os.Stderr
+	os.Stderr, _ = os.Open(os.DevNull)
+	defer func() { os.Stderr = stderr }()
+
+	if err := dispatch(context.Background(), []string{"no-such-command"}); err == nil {
+		t.Error("expected error for unknown command")
+	}
+}
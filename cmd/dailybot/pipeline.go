@@ -0,0 +1,477 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/arxiv"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/formatter"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/history"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/notifier"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/openreview"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/readinglist"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/selector"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/source"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/translator"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/venueselector"
+)
+
+// pipeline は1回の実行で使うコンポーネントをまとめたものです。
+type pipeline struct {
+	cfg         *config.Config
+	retryPolicy retry.Policy
+	orClient    *openreview.Client
+	arxivClient *arxiv.Client
+	readingList *readinglist.Source
+	history     *history.JSONStore
+	targets     []notifier.Target
+
+	interestProfile *selector.InterestProfile // INTEREST_PROFILE_PATH から読み込んだプロファイル。未指定なら nil
+}
+
+// newPipeline は設定から各コンポーネントを初期化します。OpenReview の認証情報があればログインします。
+func newPipeline(ctx context.Context, cfg *config.Config) (*pipeline, error) {
+	log.Println("INFO: Initializing components...")
+	interestProfile, err := loadInterestProfile(cfg.InterestProfilePath)
+	if err != nil {
+		return nil, err
+	}
+
+	retryPolicy := retryPolicyFromConfig(cfg)
+	orClient := openreview.NewClient(cfg.CustomUserAgent)
+	orClient.Retry = retryPolicy
+	orClient.PageSize = cfg.OpenReviewPageSize
+	orClient.MaxNotes = cfg.OpenReviewMaxNotes
+	if cfg.OpenReviewEmail != "" && cfg.OpenReviewPassword != "" {
+		if err := orClient.Login(ctx, cfg.OpenReviewEmail, cfg.OpenReviewPassword); err != nil {
+			return nil, fmt.Errorf("failed to login to openreview: %w", err)
+		}
+		log.Println("INFO: Authenticated to OpenReview.")
+	}
+
+	arxivClient := arxiv.NewClient(cfg.CustomUserAgent)
+	arxivClient.Retry = retryPolicy
+
+	postedHistory := history.NewJSONStore(cfg.HistoryPath)
+	if err := postedHistory.Load(); err != nil {
+		return nil, fmt.Errorf("failed to load posted history: %w", err)
+	}
+
+	targets := make([]notifier.Target, 0, len(cfg.TargetPlatforms))
+	for _, platform := range cfg.TargetPlatforms {
+		target, err := newTarget(cfg, platform, retryPolicy)
+		if err != nil {
+			return nil, err
+		}
+		targets = append(targets, target)
+	}
+	log.Printf("INFO: Target platforms set to %s (failure policy: %s).", strings.Join(cfg.TargetPlatforms, ", "), cfg.PostFailurePolicy)
+
+	return &pipeline{
+		cfg:         cfg,
+		retryPolicy: retryPolicy,
+		orClient:    orClient,
+		arxivClient: arxivClient,
+		readingList: readinglist.NewSource(cfg.Venues),
+		history:     postedHistory,
+		targets:     targets,
+
+		interestProfile: interestProfile,
+	}, nil
+}
+
+// loadInterestProfile は興味プロファイルの JSON ファイルを読み込み、検証します。path が空の場合は nil を返します。
+func loadInterestProfile(path string) (*selector.InterestProfile, error) {
+	if path == "" {
+		return nil, nil
+	}
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read interest profile at %s: %w", path, err)
+	}
+	var profile selector.InterestProfile
+	if err := json.Unmarshal(bytes, &profile); err != nil {
+		return nil, fmt.Errorf("failed to parse interest profile: %w", err)
+	}
+	if _, err := selector.NewInterestSelector(nil, profile); err != nil {
+		return nil, fmt.Errorf("invalid interest profile %s: %w", path, err)
+	}
+	return &profile, nil
+}
+
+// retryPolicyFromConfig は設定から HTTP リトライのポリシーを組み立てます。
+func retryPolicyFromConfig(cfg *config.Config) retry.Policy {
+	return retry.Policy{
+		MaxAttempts: cfg.RetryMaxAttempts,
+		BaseDelay:   cfg.RetryBaseDelay,
+		MaxDelay:    cfg.RetryMaxDelay,
+	}
+}
+
+// sourceFor は学会設定の type に対応する論文の取得元を返します。
+func (p *pipeline) sourceFor(venue config.VenueConfig) source.Source {
+	switch venue.Type {
+	case config.SourceArXiv:
+		return p.arxivClient
+	case config.SourceBibTeX, config.SourceCSV:
+		return p.readingList
+	default:
+		return p.orClient
+	}
+}
+
+// newVenueSelector は VENUE_SELECT_STRATEGY に対応する VenueSelector を生成します。
+func (p *pipeline) newVenueSelector(ctx context.Context) (venueselector.VenueSelector, error) {
+	countCache := map[string]int{} // フォールバック時に同じ学会の件数を取り直さないようにする
+	venueSelector, err := venueselector.New(p.cfg.VenueSelectStrategy, venueselector.Options{
+		CursorPath: p.cfg.VenueCursorPath,
+		CountPapers: func(venue config.VenueConfig) (int, error) {
+			if n, ok := countCache[venue.Venue]; ok {
+				return n, nil
+			}
+			n, err := p.sourceFor(venue).CountPapers(ctx, venue)
+			if err == nil {
+				countCache[venue.Venue] = n
+			}
+			return n, err
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create venue selector: %w", err)
+	}
+	return venueSelector, nil
+}
+
+// selectVenueAndPaper は学会を選定し、その学会から未投稿の論文を1本選定します。
+// 候補が得られなかった場合は、残りの学会から選び直します。
+// 全ての学会で候補がなかった場合は selector.ErrNoCandidates を返します。
+func (p *pipeline) selectVenueAndPaper(ctx context.Context, venueSelector venueselector.VenueSelector) (config.VenueConfig, *paper.Paper, error) {
+	log.Printf("INFO: Venue select strategy: %s (max attempts: %d)", p.cfg.VenueSelectStrategy, p.cfg.VenueMaxAttempts)
+	log.Printf("INFO: Paper select strategy: %s", p.cfg.SelectStrategy)
+
+	var selectedPaper *paper.Paper
+	selectedVenue, skipped, err := venueselector.TryVenues(ctx, venueSelector, p.cfg.Venues, p.cfg.VenueMaxAttempts, func(venue config.VenueConfig) error {
+		log.Printf("INFO: Selected venue: %s %d", venue.Name, venue.Year)
+		selected, err := p.selectPaper(ctx, venue)
+		if err != nil {
+			log.Printf("WARN: Skipping venue %s: %v", venue.Venue, err)
+			return err
+		}
+		selectedPaper = selected
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, venueselector.ErrAllVenuesSkipped) && allNoCandidates(skipped) {
+			log.Printf("INFO: No unposted valid papers found in any attempted venue (skipped: %s).", describeSkipped(skipped))
+			return config.VenueConfig{}, nil, selector.ErrNoCandidates
+		}
+		return config.VenueConfig{}, nil, fmt.Errorf("failed to select paper (skipped: %s): %w", describeSkipped(skipped), err)
+	}
+	if len(skipped) > 0 {
+		log.Printf("INFO: Fell back to %s after skipping %s.", selectedVenue.Venue, describeSkipped(skipped))
+	}
+	log.Printf("INFO: Selected paper: %s (ID: %s)", selectedPaper.Title, selectedPaper.ID)
+	return selectedVenue, selectedPaper, nil
+}
+
+// prepare は投稿前に査読結果を取得し、Abstract を翻訳します。
+func (p *pipeline) prepare(ctx context.Context, paper *paper.Paper) (string, error) {
+	if err := p.fetchReviews(ctx, paper); err != nil {
+		return "", err
+	}
+	return p.translate(ctx, paper)
+}
+
+// fetchReviews は SHOW_REVIEWS の場合に採否と査読スコアを取得して paper.Reviews に設定します。
+// 取得に失敗した場合は WARN ログを出して査読結果なしで投稿を続行します。
+func (p *pipeline) fetchReviews(ctx context.Context, paper *paper.Paper) error {
+	if !p.cfg.ShowReviews || paper.Reviews != nil || paper.Source != config.SourceOpenReview {
+		return nil // 無効、論文の取得時に取得済み、または OpenReview 以外の論文
+	}
+
+	reviews, err := p.orClient.GetReviews(ctx, paper.ID)
+	if ctx.Err() != nil {
+		return fmt.Errorf("run aborted while fetching reviews: %w", ctx.Err())
+	} else if err != nil {
+		log.Printf("WARN: failed to fetch reviews, posting without them: %v", err)
+		return nil
+	}
+	paper.Reviews = reviews
+	log.Printf("INFO: Fetched reviews (decision: %q, reviews: %d, mean rating: %.2f).", reviews.Decision, reviews.NumReviews, reviews.MeanRating())
+	return nil
+}
+
+// fetchCandidateReviews は score で選定する候補の査読結果を取得して paper.Reviews に設定します。
+// 取得に失敗した場合は WARN ログを出して査読結果なしの候補として扱います。
+func (p *pipeline) fetchCandidateReviews(ctx context.Context, paper *paper.Paper) error {
+	if paper.Reviews != nil {
+		return nil
+	}
+	reviews, err := p.orClient.GetReviews(ctx, paper.ID)
+	if ctx.Err() != nil {
+		return fmt.Errorf("run aborted while fetching reviews: %w", ctx.Err())
+	} else if err != nil {
+		log.Printf("WARN: failed to fetch reviews of candidate %s: %v", paper.ID, err)
+		return nil
+	}
+	paper.Reviews = reviews
+	return nil
+}
+
+// translate は TRANSLATE_ENABLED の場合に Abstract を日本語訳します。
+// 翻訳に失敗した場合は WARN ログを出して空文字を返します (原文のみで投稿を続行する)。
+func (p *pipeline) translate(ctx context.Context, paper *paper.Paper) (string, error) {
+	if !p.cfg.TranslateEnabled {
+		log.Println("INFO: Translation disabled.")
+		return "", nil
+	}
+
+	tr := newTranslator(p.cfg, p.retryPolicy)
+	translated, err := translator.TranslateAbstract(ctx, tr, paper, "ja")
+	if ctx.Err() != nil {
+		return "", fmt.Errorf("run aborted during translation: %w", ctx.Err())
+	} else if err != nil {
+		log.Printf("WARN: translation failed, falling back to original abstract only: %v", err)
+		return "", nil
+	}
+	log.Printf("INFO: Translated abstract (len=%d chars).", len([]rune(translated)))
+	return translated, nil
+}
+
+// newTranslator は TRANSLATOR_PROVIDER の翻訳サービスを順に試す Translator を生成します。
+// 翻訳結果は TRANSLATION_CACHE_PATH にキャッシュし、同じ Abstract を再び翻訳しないようにします。
+func newTranslator(cfg *config.Config, retryPolicy retry.Policy) translator.Translator {
+	providers := make([]translator.Provider, 0, len(cfg.TranslatorProviders))
+	for _, name := range cfg.TranslatorProviders {
+		providers = append(providers, translator.Provider{Name: name, Translator: newProviderTranslator(cfg, name, retryPolicy)})
+	}
+	return translator.NewCachedTranslator(translator.NewChainTranslator(providers...), cfg.TranslationCachePath)
+}
+
+// newProviderTranslator は翻訳サービス名に対応する Translator を生成します。
+func newProviderTranslator(cfg *config.Config, provider string, retryPolicy retry.Policy) translator.Translator {
+	switch provider {
+	case "deepl":
+		return translator.NewDeepLTranslator(cfg.DeepLEndpoint, cfg.DeepLAPIKey, retryPolicy)
+	case "google":
+		return translator.NewGoogleTranslator(cfg.GoogleTranslateEndpoint, cfg.GoogleTranslateAPIKey, retryPolicy)
+	case "libretranslate":
+		return translator.NewLibreTranslator(cfg.LibreTranslateEndpoint, cfg.LibreTranslateAPIKey, retryPolicy)
+	case "openai":
+		return translator.NewOpenAITranslator(cfg.OpenAIBaseURL, cfg.OpenAIAPIKey, cfg.OpenAIModel, retryPolicy)
+	default:
+		return translator.NewAzureTranslator(cfg.AzureTranslatorEndpoint, cfg.AzureTranslatorRegion, cfg.AzureTranslatorKey, retryPolicy)
+	}
+}
+
+// publish は論文を翻訳・整形して全ての投稿先に投稿し、投稿済みとして記録します。
+// DRY_RUN の場合は整形結果をログに出力するだけで、投稿も記録もしません。
+// 投稿に成功した場合は onPosted を呼び出します (nil 可)。
+func (p *pipeline) publish(ctx context.Context, paper *paper.Paper, venue config.VenueConfig, onPosted func() error) error {
+	// デバッグ用に取得元固有のフィールド名をログに出力
+	log.Printf("[DEBUG] Metadata fields from %s: %v", paper.Source, paper.MetadataKeys())
+
+	jaAbstract, err := p.prepare(ctx, paper)
+	if err != nil {
+		return err
+	}
+
+	format := func(f formatter.Formatter) formatter.Message {
+		return f.Format(paper, venue, p.cfg.AbstractMaxChars, jaAbstract)
+	}
+
+	if p.cfg.DryRun {
+		log.Println("INFO: Dry run mode is enabled. Skipping post.")
+		for _, target := range p.targets {
+			log.Printf("INFO: Formatted message for %s:\n%s", target.Platform, describeMessage(target.Platform, format(target.Formatter)))
+		}
+		return nil
+	}
+
+	log.Printf("INFO: Posting to %s...", strings.Join(p.cfg.TargetPlatforms, ", "))
+	results := notifier.PostAll(ctx, p.targets, format)
+
+	var succeeded, failed []string
+	for _, r := range results {
+		if r.Err != nil {
+			log.Printf("ERROR: Post to %s failed: %v", r.Platform, r.Err)
+			failed = append(failed, r.Platform)
+			continue
+		}
+		log.Printf("INFO: Post to %s successful.", r.Platform)
+		succeeded = append(succeeded, r.Platform)
+	}
+
+	// 投稿済みとして記録 (1件でも投稿できていれば、再実行時の二重投稿を防ぐため記録する)
+	if len(succeeded) > 0 {
+		entry := history.Entry{
+			Date:     time.Now().Format("2006-01-02"),
+			Venue:    venue.Venue,
+			Platform: strings.Join(succeeded, ","),
+		}
+		if err := p.history.Record(paper.ID, entry); err != nil {
+			return fmt.Errorf("failed to record posted paper: %w", err)
+		}
+		log.Printf("INFO: Recorded %s to posted history (%s).", paper.ID, p.cfg.HistoryPath)
+
+		if onPosted != nil {
+			if err := onPosted(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(failed) > 0 && (p.cfg.PostFailurePolicy == "any" || len(succeeded) == 0) {
+		return fmt.Errorf("failed to post notification to %s", strings.Join(failed, ", "))
+	}
+	if len(failed) > 0 {
+		log.Printf("WARN: Partial failure ignored by POST_FAILURE_POLICY=%s (failed: %s).", p.cfg.PostFailurePolicy, strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+// selectPaper は学会の論文一覧を取得し、投稿済みの論文を除いて1本を選定します。
+func (p *pipeline) selectPaper(ctx context.Context, venue config.VenueConfig) (*paper.Paper, error) {
+	baseSelector, err := selector.New(p.cfg.SelectStrategy, selector.Options{
+		Date:        time.Now(),
+		Venue:       venue.Venue,
+		Temperature: p.cfg.SelectTemperature,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create paper selector: %w", err)
+	}
+	if p.cfg.SelectStrategy == "score" && p.sourceFor(venue) == p.orClient {
+		// 論文一覧には査読結果を含めず (全論文の返信を取得すると重い)、絞り込んだ候補の査読だけを取得する
+		baseSelector = selector.NewShortlistSelector(baseSelector, p.cfg.ScoreShortlistSize, func(candidate selector.Paper) error {
+			return p.fetchCandidateReviews(ctx, candidate.(*paper.Paper))
+		})
+	}
+	if profile := p.interestProfile; profile != nil {
+		// 興味プロファイルで絞り込んでから選定する
+		log.Printf("INFO: Filtering candidates with interest profile %s.", p.cfg.InterestProfilePath)
+		baseSelector, err = selector.NewInterestSelector(baseSelector, *profile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create interest selector: %w", err)
+		}
+	}
+	paperSelector := selector.NewExcludingSelector(baseSelector, p.history.Contains)
+
+	log.Printf("INFO: Fetching papers from %s (Venue: %s, Status: %s)...", venue.Type, venue.Venue, venue.Status)
+	papers, err := p.sourceFor(venue).ListPapers(ctx, venue)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get papers from %s: %w", venue.Type, err)
+	}
+	log.Printf("INFO: Fetched %d papers.", len(papers))
+
+	candidates := make([]selector.Paper, len(papers))
+	byID := make(map[string]*paper.Paper, len(papers))
+	for i, paper := range papers {
+		candidates[i] = paper
+		byID[paper.ID] = paper
+	}
+
+	log.Println("INFO: Selecting a paper...")
+	selected, err := paperSelector.Select(candidates)
+	if err != nil {
+		return nil, err
+	}
+	return byID[selected.GetID()], nil
+}
+
+// allNoCandidates は全てのスキップ理由が「候補なし」かどうかを返します。
+func allNoCandidates(skipped []venueselector.Skipped) bool {
+	for _, s := range skipped {
+		if !errors.Is(s.Err, selector.ErrNoCandidates) {
+			return false
+		}
+	}
+	return true
+}
+
+// describeSkipped はスキップした学会と理由をログ用の文字列にします。
+func describeSkipped(skipped []venueselector.Skipped) string {
+	if len(skipped) == 0 {
+		return "none"
+	}
+	parts := make([]string, len(skipped))
+	for i, s := range skipped {
+		parts[i] = fmt.Sprintf("%s (%v)", s.Venue.Venue, s.Err)
+	}
+	return strings.Join(parts, "; ")
+}
+
+// describeMessage は投稿される内容をプラットフォームごとに表示用の文字列にします (preview と DRY_RUN 用)。
+// Slack の Block Kit と Discord の embed は、投稿時に送る JSON をそのまま出力します。
+func describeMessage(platform string, msg formatter.Message) string {
+	var b strings.Builder
+	section := func(title, body string) {
+		fmt.Fprintf(&b, "=== %s: %s ===\n%s\n", platform, title, body)
+	}
+	marshal := func(v any) string {
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Sprintf("(failed to marshal: %v)", err)
+		}
+		return string(data)
+	}
+
+	switch {
+	case len(msg.Blocks) > 0:
+		section("Blocks", marshal(msg.Blocks))
+		section("Main (notification text)", msg.Main)
+	case len(msg.Embeds) > 0:
+		section("Embeds", marshal(msg.Embeds))
+	default:
+		section("Main", msg.Main)
+	}
+	if msg.Sub != "" {
+		section("Sub", msg.Sub)
+	}
+	return b.String()
+}
+
+// newTarget はプラットフォーム名に対応する Notifier と Formatter の組を生成します。
+func newTarget(cfg *config.Config, platform string, retryPolicy retry.Policy) (notifier.Target, error) {
+	switch platform {
+	case "slack":
+		slackFormatter, err := formatter.NewSlackFormatterFromTemplate(cfg.SlackTemplatePath)
+		if err != nil {
+			return notifier.Target{}, err
+		}
+		if cfg.SlackMessageStyle == "text" {
+			slackFormatter = formatter.TextOnly(slackFormatter)
+		}
+		return notifier.Target{
+			Platform:  platform,
+			Notifier:  notifier.NewSlackNotifier(cfg.SlackBotToken, cfg.SlackChannelID),
+			Formatter: slackFormatter,
+		}, nil
+	case "discord":
+		discordFormatter, err := formatter.NewDiscordFormatterFromTemplate(cfg.DiscordTemplatePath)
+		if err != nil {
+			return notifier.Target{}, err
+		}
+		if cfg.DiscordMessageStyle == "text" {
+			discordFormatter = formatter.TextOnly(discordFormatter)
+		}
+		discordNotifier := notifier.NewDiscordNotifier(cfg.DiscordWebhookURL, retryPolicy)
+		discordNotifier.SubMode = cfg.DiscordSubMode
+		return notifier.Target{
+			Platform:  platform,
+			Notifier:  discordNotifier,
+			Formatter: discordFormatter,
+		}, nil
+	default:
+		return notifier.Target{}, fmt.Errorf("invalid target platform: %s", platform)
+	}
+}
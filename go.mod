@@ -4,6 +4,7 @@ go 1.25.6
 
 require (
 	github.com/joho/godotenv v1.5.1
+	github.com/robfig/cron/v3 v3.0.1
 	github.com/slack-go/slack v0.17.3
 )
 
@@ -0,0 +1,299 @@
+package arxiv
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
+)
+
+const (
+	// DefaultPageSize は1ページあたりの取得件数のデフォルト値です。
+	DefaultPageSize = 100
+	// DefaultMaxPapers は ListPapers で取得する論文数の上限のデフォルト値です。
+	DefaultMaxPapers = 1000
+	// DefaultWindowDays は VenueConfig.WindowDays が未指定の場合の取得期間 (日数) です。
+	DefaultWindowDays = 7
+	// DefaultPageDelay は連続するリクエストの間隔です。arXiv API の利用規約では 3 秒以上空けることが求められています。
+	DefaultPageDelay = 3 * time.Second
+)
+
+var ErrPaperNotFound = errors.New("paper not found")
+
+// idPattern は新形式 (2401.01234) と旧形式 (hep-th/9901001) の arXiv ID にバージョン番号を含めて一致します。
+var idPattern = regexp.MustCompile(`^(\d{4}\.\d{4,5}|[a-z\-]+(?:\.[A-Z]{2})?/\d{7})(v\d+)?$`)
+
+// Client は arXiv API (Atom フィード) と通信するためのクライアントです。
+type Client struct {
+	httpClient *http.Client
+	BaseURL    string
+	UserAgent  string
+	PageSize   int           // 1ページあたりの取得件数
+	MaxPapers  int           // ListPapers で取得する論文数の上限 (0以下で無制限)
+	PageDelay  time.Duration // リクエストの間隔。ページ送りに限らず、同じクライアントからの全てのリクエストの間に空ける
+	Retry      retry.Policy
+
+	now func() time.Time // テストで現在時刻を固定するためのフック
+
+	mu          sync.Mutex
+	lastRequest time.Time // 直前のリクエストの送信時刻
+}
+
+// NewClient は新しい arXiv クライアントを生成します。
+func NewClient(userAgent string) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		BaseURL:    "https://export.arxiv.org/api/query",
+		UserAgent:  userAgent,
+		PageSize:   DefaultPageSize,
+		MaxPapers:  DefaultMaxPapers,
+		PageDelay:  DefaultPageDelay,
+		Retry:      retry.DefaultPolicy(),
+		now:        time.Now,
+	}
+}
+
+// --- Atom Feed Structures ---
+
+// feed は arXiv API のレスポンス (Atom フィード) です。
+type feed struct {
+	TotalResults int     `xml:"http://a9.com/-/spec/opensearch/1.1/ totalResults"`
+	Entries      []entry `xml:"entry"`
+}
+
+// entry は論文1件です。
+type entry struct {
+	ID              string     `xml:"id"` // 例: "http://arxiv.org/abs/2401.01234v2"
+	Published       time.Time  `xml:"published"`
+	Updated         time.Time  `xml:"updated"`
+	Title           string     `xml:"title"`
+	Summary         string     `xml:"summary"`
+	Authors         []author   `xml:"author"`
+	Links           []link     `xml:"link"`
+	PrimaryCategory category   `xml:"http://arxiv.org/schemas/atom primary_category"`
+	Categories      []category `xml:"category"`
+	Comment         string     `xml:"http://arxiv.org/schemas/atom comment"`
+	JournalRef      string     `xml:"http://arxiv.org/schemas/atom journal_ref"`
+}
+
+type author struct {
+	Name string `xml:"name"`
+}
+
+type link struct {
+	Href  string `xml:"href,attr"`
+	Rel   string `xml:"rel,attr"`
+	Title string `xml:"title,attr"`
+}
+
+type category struct {
+	Term string `xml:"term,attr"`
+}
+
+// ListPapers は venue.Venue を検索クエリ (例: "cat:cs.CV", "cat:cs.CL AND abs:translation") として、
+// 直近 venue.WindowDays 日 (未指定なら DefaultWindowDays) に投稿された論文を新しい順に取得します。
+func (c *Client) ListPapers(ctx context.Context, venue config.VenueConfig) ([]*paper.Paper, error) {
+	pageSize := c.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	query := c.windowQuery(venue)
+
+	var papers []*paper.Paper
+	for start := 0; ; start += pageSize {
+		limit := pageSize
+		if c.MaxPapers > 0 && start+limit > c.MaxPapers {
+			limit = c.MaxPapers - start
+		}
+		page, err := c.search(ctx, url.Values{"search_query": {query}}, start, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch arxiv papers (start=%d): %w", start, err)
+		}
+		for _, e := range page.Entries {
+			papers = append(papers, e.toPaper())
+		}
+
+		if len(page.Entries) == 0 || len(page.Entries) < limit {
+			break // 最終ページ
+		}
+		if page.TotalResults > 0 && len(papers) >= page.TotalResults {
+			break // 全件取得済み
+		}
+		if c.MaxPapers > 0 && len(papers) >= c.MaxPapers {
+			break // 上限到達
+		}
+	}
+	return papers, nil
+}
+
+// CountPapers は ListPapers の対象となる論文の件数を返します。論文本体は取得しません。
+func (c *Client) CountPapers(ctx context.Context, venue config.VenueConfig) (int, error) {
+	page, err := c.search(ctx, url.Values{"search_query": {c.windowQuery(venue)}}, 0, 0)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count arxiv papers: %w", err)
+	}
+	return page.TotalResults, nil
+}
+
+// GetPaper は arXiv ID (バージョン番号や URL も可) を指定して論文を1件取得します。
+// 該当する論文がない場合は ErrPaperNotFound を返します。
+func (c *Client) GetPaper(ctx context.Context, id string) (*paper.Paper, error) {
+	id, ok := ParseID(id)
+	if !ok {
+		return nil, fmt.Errorf("%w: invalid arxiv id %q", ErrPaperNotFound, id)
+	}
+	page, err := c.search(ctx, url.Values{"id_list": {id}}, 0, 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch arxiv paper %s: %w", id, err)
+	}
+	// 存在しない ID にはタイトルが "Error" のエントリが返る
+	if len(page.Entries) == 0 || page.Entries[0].Title == "Error" {
+		return nil, fmt.Errorf("%w: %s", ErrPaperNotFound, id)
+	}
+	return page.Entries[0].toPaper(), nil
+}
+
+// ParseID は arXiv ID または abs / pdf ページの URL からバージョン番号を除いた ID を取り出します。
+// arXiv ID として解釈できない場合は false を返します。
+func ParseID(s string) (string, bool) {
+	s = strings.TrimSpace(s)
+	s = strings.TrimPrefix(s, "arXiv:")
+	if u, err := url.Parse(s); err == nil && strings.HasSuffix(u.Host, "arxiv.org") {
+		s = strings.TrimPrefix(u.Path, "/")
+		s = strings.TrimPrefix(strings.TrimPrefix(s, "abs/"), "pdf/")
+		s = strings.TrimSuffix(s, ".pdf")
+	}
+	m := idPattern.FindStringSubmatch(s)
+	if m == nil {
+		return s, false
+	}
+	return m[1], true
+}
+
+// windowQuery は検索クエリに投稿日の範囲を加えます。
+func (c *Client) windowQuery(venue config.VenueConfig) string {
+	days := venue.WindowDays
+	if days <= 0 {
+		days = DefaultWindowDays
+	}
+	to := c.now().UTC()
+	from := to.AddDate(0, 0, -days)
+	const layout = "200601021504"
+	return fmt.Sprintf("(%s) AND submittedDate:[%s TO %s]", venue.Venue, from.Format(layout), to.Format(layout))
+}
+
+// wait は直前のリクエストから PageDelay が経過するまで待ちます。
+// CountPapers の直後の ListPapers のように、別のメソッドから続けて呼ばれた場合も間隔を空けます。
+func (c *Client) wait(ctx context.Context) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if d := time.Until(c.lastRequest.Add(c.PageDelay)); !c.lastRequest.IsZero() && d > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(d):
+		}
+	}
+	c.lastRequest = time.Now()
+	return nil
+}
+
+// search は API から1ページ分の論文を新しい順に取得します。
+func (c *Client) search(ctx context.Context, query url.Values, start, maxResults int) (*feed, error) {
+	if err := c.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("start", strconv.Itoa(start))
+	q.Set("max_results", strconv.Itoa(maxResults))
+	if _, ok := query["search_query"]; ok {
+		q.Set("sortBy", "submittedDate")
+		q.Set("sortOrder", "descending")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"?"+q.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("User-Agent", c.UserAgent)
+
+	resp, err := c.Retry.Do(c.httpClient, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	}
+
+	var f feed
+	if err := xml.NewDecoder(resp.Body).Decode(&f); err != nil {
+		return nil, fmt.Errorf("failed to decode response body: %w", err)
+	}
+	return &f, nil
+}
+
+// toPaper はエントリを paper.Paper に変換します。
+// カテゴリは Keywords、主カテゴリは PrimaryArea、journal_ref (なければ "arXiv") は Venue に入ります。
+func (e entry) toPaper() *paper.Paper {
+	id, _ := ParseID(e.ID)
+	authors := make([]string, len(e.Authors))
+	for i, a := range e.Authors {
+		authors[i] = collapseSpace(a.Name)
+	}
+	categories := make([]string, 0, len(e.Categories))
+	for _, c := range e.Categories {
+		categories = append(categories, c.Term)
+	}
+
+	p := &paper.Paper{
+		ID:          id,
+		Source:      config.SourceArXiv,
+		Title:       collapseSpace(e.Title),
+		Authors:     authors,
+		Abstract:    collapseSpace(e.Summary),
+		Keywords:    categories,
+		PrimaryArea: e.PrimaryCategory.Term,
+		URL:         "https://arxiv.org/abs/" + id,
+		Venue:       "arXiv",
+		Published:   e.Published,
+		Metadata:    map[string]string{},
+	}
+	for _, l := range e.Links {
+		switch {
+		case l.Rel == "alternate":
+			p.URL = l.Href
+		case l.Title == "pdf":
+			p.PDFURL = l.Href
+		}
+	}
+	if e.JournalRef != "" {
+		p.Venue = collapseSpace(e.JournalRef)
+		p.Metadata["journal_ref"] = p.Venue
+	}
+	if e.Comment != "" {
+		p.Metadata["comment"] = collapseSpace(e.Comment)
+	}
+	return p
+}
+
+// collapseSpace は Atom フィードのテキストに含まれる改行と連続する空白を1つの空白にまとめます。
+func collapseSpace(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,250 @@
+package arxiv
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
+)
+
+// newTestClient は fixtures を返すテストサーバーに向けたクライアントを生成します。
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	client := NewClient("test-agent")
+	client.BaseURL = server.URL
+	client.PageDelay = 0
+	client.Retry = retry.NoRetry()
+	client.now = func() time.Time { return time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC) }
+	return client
+}
+
+// serveFixture は testdata 以下の Atom XML をレスポンスとして書き出します。
+func serveFixture(t *testing.T, w http.ResponseWriter, name string) {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+	w.Header().Set("Content-Type", "application/atom+xml")
+	w.Write(body)
+}
+
+func TestListPapers(t *testing.T) {
+	venue := config.VenueConfig{Name: "arXiv cs.CL", Venue: "cat:cs.CL", Type: config.SourceArXiv, WindowDays: 3}
+
+	t.Run("fetches all pages within the date window", func(t *testing.T) {
+		var starts []string
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			q := r.URL.Query()
+			if got, want := q.Get("search_query"), "(cat:cs.CL) AND submittedDate:[202401120000 TO 202401150000]"; got != want {
+				t.Errorf("unexpected search_query.\nGot:  %s\nWant: %s", got, want)
+			}
+			if q.Get("sortBy") != "submittedDate" || q.Get("sortOrder") != "descending" {
+				t.Errorf("unexpected sort: %s %s", q.Get("sortBy"), q.Get("sortOrder"))
+			}
+			if r.Header.Get("User-Agent") != "test-agent" {
+				t.Errorf("unexpected User-Agent: %s", r.Header.Get("User-Agent"))
+			}
+			starts = append(starts, q.Get("start"))
+			if q.Get("start") == "0" {
+				serveFixture(t, w, "search_page1.xml")
+			} else {
+				serveFixture(t, w, "search_page2.xml")
+			}
+		})
+		client.PageSize = 2
+
+		papers, err := client.ListPapers(context.Background(), venue)
+		if err != nil {
+			t.Fatalf("ListPapers() failed: %v", err)
+		}
+		if !reflect.DeepEqual(starts, []string{"0", "2"}) {
+			t.Errorf("unexpected pages requested: %v", starts)
+		}
+		var ids []string
+		for _, n := range papers {
+			ids = append(ids, n.ID)
+		}
+		if want := []string{"2401.07654", "2401.06001", "2401.05123"}; !reflect.DeepEqual(ids, want) {
+			t.Errorf("expected %v, got %v", want, ids)
+		}
+	})
+
+	t.Run("converts entries to papers", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			serveFixture(t, w, "search_page1.xml")
+		})
+		client.MaxPapers = 2
+
+		papers, err := client.ListPapers(context.Background(), venue)
+		if err != nil {
+			t.Fatalf("ListPapers() failed: %v", err)
+		}
+		if len(papers) != 2 {
+			t.Fatalf("expected 2 papers (MaxPapers), got %d", len(papers))
+		}
+
+		n := papers[0]
+		if n.Source != config.SourceArXiv || n.URL != "http://arxiv.org/abs/2401.07654v2" {
+			t.Errorf("unexpected source/url: %q %q", n.Source, n.URL)
+		}
+		if n.GetTitle() != "Sparse Mixture-of-Experts for Low-Resource Machine Translation" {
+			t.Errorf("title whitespace not normalized: %q", n.GetTitle())
+		}
+		if !strings.HasPrefix(n.GetAbstract(), "We study sparse") || strings.Contains(n.GetAbstract(), "\n") {
+			t.Errorf("abstract whitespace not normalized: %q", n.GetAbstract())
+		}
+		if !reflect.DeepEqual(n.Authors, []string{"Alice Smith", "Bob Tanaka"}) {
+			t.Errorf("unexpected authors: %v", n.Authors)
+		}
+		if !reflect.DeepEqual(n.GetKeywords(), []string{"cs.CL", "cs.LG"}) || n.GetPrimaryArea() != "cs.CL" {
+			t.Errorf("unexpected categories: %v / %q", n.GetKeywords(), n.GetPrimaryArea())
+		}
+		if n.PDFURL != "http://arxiv.org/pdf/2401.07654v2" {
+			t.Errorf("unexpected pdf: %q", n.PDFURL)
+		}
+		if n.Venue != "arXiv" {
+			t.Errorf("expected venue arXiv, got %q", n.Venue)
+		}
+		if want := time.Date(2024, 1, 12, 9, 1, 45, 0, time.UTC); !n.Published.Equal(want) {
+			t.Errorf("expected published %v, got %v", want, n.Published)
+		}
+		if n.Metadata["comment"] != "12 pages, 4 figures" {
+			t.Errorf("expected comment in metadata, got %v", n.Metadata)
+		}
+
+		if papers[1].Venue != "ACL 2024" {
+			t.Errorf("expected journal_ref as venue, got %q", papers[1].Venue)
+		}
+	})
+
+	t.Run("server error", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		})
+		if _, err := client.ListPapers(context.Background(), venue); err == nil {
+			t.Error("expected error for 503 response")
+		}
+	})
+}
+
+func TestCountPapers(t *testing.T) {
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("max_results") != "0" {
+			t.Errorf("expected max_results=0, got %s", r.URL.Query().Get("max_results"))
+		}
+		serveFixture(t, w, "count.xml")
+	})
+
+	n, err := client.CountPapers(context.Background(), config.VenueConfig{Venue: "cat:cs.CL"})
+	if err != nil {
+		t.Fatalf("CountPapers() failed: %v", err)
+	}
+	if n != 421 {
+		t.Errorf("expected 421, got %d", n)
+	}
+}
+
+func TestRequestDelay(t *testing.T) {
+	const delay = 50 * time.Millisecond
+	var sent []time.Time
+	client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		sent = append(sent, time.Now())
+		serveFixture(t, w, "count.xml")
+	})
+	client.PageDelay = delay
+
+	// paper-count の学会選定のように、別のメソッドから続けて呼んでも間隔を空ける
+	venue := config.VenueConfig{Venue: "cat:cs.CL"}
+	if _, err := client.CountPapers(context.Background(), venue); err != nil {
+		t.Fatalf("CountPapers() failed: %v", err)
+	}
+	if _, err := client.CountPapers(context.Background(), venue); err != nil {
+		t.Fatalf("CountPapers() failed: %v", err)
+	}
+	if len(sent) != 2 {
+		t.Fatalf("expected 2 requests, got %d", len(sent))
+	}
+	if gap := sent[1].Sub(sent[0]); gap < delay {
+		t.Errorf("expected at least %s between requests, got %s", delay, gap)
+	}
+
+	t.Run("canceled while waiting", func(t *testing.T) {
+		client.PageDelay = time.Hour
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := client.CountPapers(ctx, venue); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+	})
+}
+
+func TestGetPaper(t *testing.T) {
+	t.Run("found", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			if got := r.URL.Query().Get("id_list"); got != "2401.05123" {
+				t.Errorf("expected id_list=2401.05123, got %s", got)
+			}
+			if r.URL.Query().Has("search_query") {
+				t.Error("id_list lookup should not send search_query")
+			}
+			serveFixture(t, w, "search_page2.xml")
+		})
+
+		p, err := client.GetPaper(context.Background(), "https://arxiv.org/abs/2401.05123v1")
+		if err != nil {
+			t.Fatalf("GetPaper() failed: %v", err)
+		}
+		if p.ID != "2401.05123" || p.Title != "Tokenizer-Free Language Modeling" {
+			t.Errorf("unexpected paper: %s %q", p.ID, p.Title)
+		}
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		client := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+			serveFixture(t, w, "not_found.xml")
+		})
+
+		_, err := client.GetPaper(context.Background(), "2401.99999")
+		if !errors.Is(err, ErrPaperNotFound) {
+			t.Errorf("expected ErrPaperNotFound, got %v", err)
+		}
+	})
+}
+
+func TestParseID(t *testing.T) {
+	tests := []struct {
+		in     string
+		want   string
+		wantOK bool
+	}{
+		{"2401.01234", "2401.01234", true},
+		{"2401.01234v3", "2401.01234", true},
+		{"arXiv:2401.01234", "2401.01234", true},
+		{"https://arxiv.org/abs/2401.01234v2", "2401.01234", true},
+		{"https://arxiv.org/pdf/2401.01234v2.pdf", "2401.01234", true},
+		{"http://arxiv.org/abs/hep-th/9901001v1", "hep-th/9901001", true},
+		{"https://openreview.net/forum?id=ABC123", "", false},
+		{"ABC123xyz", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			got, ok := ParseID(tt.in)
+			if ok != tt.wantOK || (ok && got != tt.want) {
+				t.Errorf("ParseID(%q) = %q, %v; want %q, %v", tt.in, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+// Package atomicfile は書き込み途中のファイルが残らないようにファイルを書き出します。
+package atomicfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// WriteJSON は v をインデント付きの JSON として path に書き出します。
+// 同じディレクトリの一時ファイルに書き出してからリネームするため、書き込み中に中断しても元のファイルは壊れません。
+// ディレクトリがない場合は作成します。
+func WriteJSON(path string, v any) error {
+	bytes, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s: %w", path, err)
+	}
+	return Write(path, append(bytes, '\n'))
+}
+
+// Write は data を path に書き出します。一時ファイルに書き出してからリネームします。
+func Write(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create dir %s: %w", dir, err)
+	}
+
+	// 一時ファイル名は ".<ファイル名>-*<拡張子>" (例: ".posted-123.json")
+	base := filepath.Base(path)
+	ext := filepath.Ext(base)
+	tmp, err := os.CreateTemp(dir, "."+strings.TrimSuffix(base, ext)+"-*"+ext)
+	if err != nil {
+		return fmt.Errorf("failed to create temp file for %s: %w", path, err)
+	}
+	defer os.Remove(tmp.Name())
+
+	if err := tmp.Chmod(0644); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to chmod %s: %w", path, err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to sync %s: %w", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to close %s: %w", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to replace %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteJSON(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data", "state.json")
+
+	t.Run("creates the directory and writes indented JSON", func(t *testing.T) {
+		if err := WriteJSON(path, map[string]string{"key": "value"}); err != nil {
+			t.Fatalf("WriteJSON() failed: %v", err)
+		}
+		got, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("failed to read file: %v", err)
+		}
+		if string(got) != "{\n  \"key\": \"value\"\n}\n" {
+			t.Errorf("unexpected content: %q", got)
+		}
+	})
+
+	t.Run("replaces the file without leaving temp files", func(t *testing.T) {
+		if err := WriteJSON(path, []int{1, 2}); err != nil {
+			t.Fatalf("WriteJSON() failed: %v", err)
+		}
+		entries, err := os.ReadDir(filepath.Dir(path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(entries) != 1 || entries[0].Name() != "state.json" {
+			t.Errorf("expected only state.json, got %v", entries)
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if info.Mode().Perm() != 0644 {
+			t.Errorf("expected mode 0644, got %v", info.Mode().Perm())
+		}
+	})
+
+	t.Run("unmarshalable value returns error", func(t *testing.T) {
+		if err := WriteJSON(path, func() {}); err == nil {
+			t.Error("expected error")
+		}
+	})
+}
@@ -4,37 +4,99 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"regexp"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/selector"
 )
 
 var venuesConfigPath = "assets/venues.json"
 
+var hexColorPattern = regexp.MustCompile(`^#[0-9A-Fa-f]{6}$`)
+
+// translatorProviders は TRANSLATOR_PROVIDER に指定できる値です。
+var translatorProviders = []string{"azure", "deepl", "google", "libretranslate", "openai"}
+
 // VenueConfig は一つの学会に関する設定を保持します。
 type VenueConfig struct {
-	Name  string `json:"name"`  // 表示名 (例: "ICLR")
-	Venue string `json:"venue"` // API用Venue ID
-	Year  int    `json:"year"`  // 年
+	Name   string  `json:"name"`             // 表示名 (例: "ICLR")
+	Venue  string  `json:"venue"`            // API用Venue ID
+	Year   int     `json:"year"`             // 年
+	Status string  `json:"status,omitempty"` // 論文ステータスフィルタ: "accepted" (デフォルト) / "all" / 採択区分 ("oral", "spotlight", "poster" 等)
+	Color  string  `json:"color,omitempty"`  // Discord embed の色 ("#RRGGBB")。未指定なら学会名から自動で選ぶ
+	Weight float64 `json:"weight,omitempty"` // VENUE_SELECT_STRATEGY=weighted での選ばれやすさ。未指定なら 1
+
+	Type       string `json:"type,omitempty"`        // 論文の取得元: "openreview" (デフォルト) / "arxiv" / "bibtex" / "csv"
+	WindowDays int    `json:"window_days,omitempty"` // type=arxiv で遡る日数。未指定なら 7
 }
 
+// 論文の取得元 (VenueConfig.Type) の一覧です。
+const (
+	SourceOpenReview = "openreview"
+	SourceArXiv      = "arxiv"
+	SourceBibTeX     = "bibtex" // venue に .bib ファイルのパスを指定する
+	SourceCSV        = "csv"    // venue に .csv ファイルのパスを指定する
+)
+
 // Config はアプリケーション全体の設定を保持します。
 type Config struct {
 	// OpenReview
 	Venues []VenueConfig // 複数学会を保持
 
 	// Target Platform
-	TargetPlatform string
+	TargetPlatforms   []string // 投稿先 (TARGET_PLATFORM をカンマ区切りで指定)
+	PostFailurePolicy string   // 一部の投稿先が失敗した場合の扱い: "any" (1件でも失敗したら失敗) / "all" (全件失敗で失敗)
 
 	// Slack
-	SlackBotToken  string
-	SlackChannelID string
+	SlackBotToken     string
+	SlackChannelID    string
+	SlackTemplatePath string // メッセージテンプレート (text/template)。空ならデフォルトテンプレート
+	SlackMessageStyle string // 投稿形式: "blocks" (Block Kit) / "text" (テンプレートの main をそのまま投稿)
 
 	// Discord
-	DiscordWebhookURL string
+	DiscordWebhookURL   string
+	DiscordSubMode      string // Sub の投稿方法: "followup" / "thread" (フォーラムチャンネルの Webhook のみ。それ以外では followup として投稿)
+	DiscordTemplatePath string // メッセージテンプレート (text/template)。空ならデフォルトテンプレート
+	DiscordMessageStyle string // 投稿形式: "embed" / "text" (テンプレートの main をそのまま投稿)
+
+	// Venue Selector
+	VenueSelectStrategy string
+	VenueCursorPath     string // round-robin のカーソルファイル
+	VenueMaxAttempts    int    // 論文が得られなかった場合に別の学会を試す、最初の学会を含めた最大件数
 
 	// Selector
-	SelectStrategy   string
-	AbstractMaxChars int
-	DryRun           bool
+	SelectStrategy     string
+	SelectTemperature  float64 // SELECT_STRATEGY=score の温度。0 で常に最高スコア、高いほどランダム
+	ScoreShortlistSize int     // SELECT_STRATEGY=score で査読結果を取得する候補数 (OpenReview のみ)
+	AbstractMaxChars   int
+	DryRun             bool
+
+	// Interest profile
+	InterestProfilePath string // 興味プロファイル (JSON) のパス。空なら絞り込まない
+
+	// Reviews
+	ShowReviews bool // 採否と査読スコアを取得して投稿に含める
+
+	// History
+	HistoryPath string
+
+	// Paper request
+	PaperID string // 指定した場合は選定処理を行わず、この論文を投稿する (PAPER_ID)
+
+	// Run
+	RunTimeout time.Duration
+
+	// Daemon (serve モード)
+	ScheduleCron     string         // 実行スケジュールの cron 式
+	ScheduleLocation *time.Location // cron 式を解釈するタイムゾーン
+
+	// HTTP Retry
+	RetryMaxAttempts int
+	RetryBaseDelay   time.Duration
+	RetryMaxDelay    time.Duration
 
 	// Misc
 	CustomUserAgent string
@@ -43,63 +105,268 @@ type Config struct {
 	OpenReviewEmail    string
 	OpenReviewPassword string
 
+	// OpenReview Pagination
+	OpenReviewPageSize int
+	OpenReviewMaxNotes int
+
 	// Translation
-	TranslateEnabled        bool
+	TranslateEnabled     bool
+	TranslatorProviders  []string // 翻訳サービス ("azure" (デフォルト) / "deepl" / "google" / "libretranslate" / "openai")。失敗したら次を試す
+	TranslationCachePath string   // 翻訳結果のキャッシュファイル
+
+	// Azure AI Translator
 	AzureTranslatorEndpoint string
 	AzureTranslatorRegion   string
 	AzureTranslatorKey      string
-}
 
-// Load は環境変数と設定ファイルから設定を読み込み、検証します。
-func Load() (*Config, error) {
-	cfg := &Config{}
-	var err error
+	// DeepL API
+	DeepLEndpoint string // 未指定ならキーに応じて Free ("...:fx") / Pro の API を使う
+	DeepLAPIKey   string
 
-	// --- ファイルからの設定 ---
+	// Google Cloud Translation (v2)
+	GoogleTranslateEndpoint string
+	GoogleTranslateAPIKey   string
+
+	// LibreTranslate
+	LibreTranslateEndpoint string
+	LibreTranslateAPIKey   string // インスタンスが要求する場合のみ
 
-	// assets/venues.json から学会リストを読み込む
+	// OpenAI 互換の Chat Completions API
+	OpenAIBaseURL string
+	OpenAIAPIKey  string // api.openai.com 以外 (ローカルの互換サーバーなど) では省略可
+	OpenAIModel   string
+}
+
+// LoadVenues は assets/venues.json から学会リストを読み込み、検証します。
+// Load と異なり環境変数を必要としないため、学会リストだけを参照する用途に使えます。
+func LoadVenues() ([]VenueConfig, error) {
 	bytes, err := os.ReadFile(venuesConfigPath)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read venues config file at %s: %w", venuesConfigPath, err)
 	}
-	if err := json.Unmarshal(bytes, &cfg.Venues); err != nil {
+	var venues []VenueConfig
+	if err := json.Unmarshal(bytes, &venues); err != nil {
 		return nil, fmt.Errorf("failed to parse venues config file: %w", err)
 	}
-	if len(cfg.Venues) == 0 {
+	if len(venues) == 0 {
 		return nil, fmt.Errorf("no venues found in %s", venuesConfigPath)
 	}
+	for i := range venues {
+		typ := strings.ToLower(strings.TrimSpace(venues[i].Type))
+		if typ == "" {
+			typ = SourceOpenReview
+		}
+		if !slices.Contains([]string{SourceOpenReview, SourceArXiv, SourceBibTeX, SourceCSV}, typ) {
+			return nil, fmt.Errorf("invalid type %q for venue %s. must be one of: openreview, arxiv, bibtex, csv", venues[i].Type, venues[i].Venue)
+		}
+		venues[i].Type = typ
+		if venues[i].WindowDays < 0 {
+			return nil, fmt.Errorf("invalid window_days %d for venue %s. must not be negative", venues[i].WindowDays, venues[i].Venue)
+		}
+
+		status := strings.ToLower(strings.TrimSpace(venues[i].Status))
+		if status == "" {
+			status = "accepted"
+		}
+		if strings.ContainsAny(status, " /") {
+			return nil, fmt.Errorf("invalid status %q for venue %s", venues[i].Status, venues[i].Venue)
+		}
+		venues[i].Status = status
+
+		if venues[i].Weight < 0 {
+			return nil, fmt.Errorf("invalid weight %v for venue %s. must not be negative", venues[i].Weight, venues[i].Venue)
+		}
+
+		if color := venues[i].Color; color != "" && !hexColorPattern.MatchString(color) {
+			return nil, fmt.Errorf("invalid color %q for venue %s. must be '#RRGGBB'", color, venues[i].Venue)
+		}
+	}
+	return venues, nil
+}
+
+// VenueFromID は OpenReview の Venue ID (例: "ICLR.cc/2025/Conference") から学会設定を組み立てます。
+// assets/venues.json にない学会の論文を投稿する場合に使います。
+// 不採択・取り下げ論文の venueid (例: ".../Conference/Rejected_Submission") は学会の Venue ID に戻します。
+func VenueFromID(venueID string) (VenueConfig, error) {
+	segments := strings.Split(strings.Trim(strings.TrimSpace(venueID), "/"), "/")
+	status := "accepted"
+	if last := segments[len(segments)-1]; len(segments) > 1 && strings.HasSuffix(last, "Submission") {
+		segments = segments[:len(segments)-1]
+		status = "all"
+	}
+
+	// 学会名は年の直前のセグメントからドメイン部分 (".cc" など) を除いたもの
+	// (例: "ICLR.cc/2025/Conference" → "ICLR", "colmweb.org/COLM/2024/Conference" → "COLM")
+	name, year := "", 0
+	for i := 1; i < len(segments); i++ {
+		if y, err := strconv.Atoi(segments[i]); err == nil && len(segments[i]) == 4 {
+			name, _, _ = strings.Cut(segments[i-1], ".")
+			year = y
+			break
+		}
+	}
+	if name == "" || year == 0 {
+		return VenueConfig{}, fmt.Errorf("cannot derive venue from venueid %q", venueID)
+	}
+
+	return VenueConfig{
+		Name:   name,
+		Venue:  strings.Join(segments, "/"),
+		Year:   year,
+		Status: status,
+	}, nil
+}
+
+// HistoryPath は投稿履歴ファイルのパス (HISTORY_PATH、未指定の場合は data/posted.json) を返します。
+func HistoryPath() string {
+	if path := os.Getenv("HISTORY_PATH"); path != "" {
+		return path
+	}
+	return "data/posted.json"
+}
+
+// Load は環境変数と設定ファイルから設定を読み込み、検証します。
+func Load() (*Config, error) {
+	cfg := &Config{}
+	var err error
+
+	// --- ファイルからの設定 ---
+
+	cfg.Venues, err = LoadVenues()
+	if err != nil {
+		return nil, err
+	}
 
 	// --- 環境変数からの設定 ---
 
-	// TargetPlatform
-	cfg.TargetPlatform = os.Getenv("TARGET_PLATFORM")
-	if cfg.TargetPlatform == "" {
+	// TargetPlatform (例: "slack" / "slack,discord")
+	targetPlatformStr := os.Getenv("TARGET_PLATFORM")
+	if targetPlatformStr == "" {
+		return nil, fmt.Errorf("environment variable TARGET_PLATFORM is required")
+	}
+	seen := map[string]bool{}
+	for _, platform := range strings.Split(targetPlatformStr, ",") {
+		platform = strings.TrimSpace(platform)
+		if platform == "" || seen[platform] {
+			continue
+		}
+		seen[platform] = true
+		cfg.TargetPlatforms = append(cfg.TargetPlatforms, platform)
+	}
+	if len(cfg.TargetPlatforms) == 0 {
 		return nil, fmt.Errorf("environment variable TARGET_PLATFORM is required")
 	}
 
 	// プラットフォームに応じた必須項目
-	switch cfg.TargetPlatform {
-	case "slack":
-		cfg.SlackBotToken = os.Getenv("SLACK_BOT_TOKEN")
-		cfg.SlackChannelID = os.Getenv("SLACK_CHANNEL_ID")
-		if cfg.SlackBotToken == "" || cfg.SlackChannelID == "" {
-			return nil, fmt.Errorf("SLACK_BOT_TOKEN and SLACK_CHANNEL_ID are required for slack platform")
+	for _, platform := range cfg.TargetPlatforms {
+		switch platform {
+		case "slack":
+			cfg.SlackBotToken = os.Getenv("SLACK_BOT_TOKEN")
+			cfg.SlackChannelID = os.Getenv("SLACK_CHANNEL_ID")
+			if cfg.SlackBotToken == "" || cfg.SlackChannelID == "" {
+				return nil, fmt.Errorf("SLACK_BOT_TOKEN and SLACK_CHANNEL_ID are required for slack platform")
+			}
+			cfg.SlackTemplatePath = os.Getenv("SLACK_TEMPLATE_PATH")
+			cfg.SlackMessageStyle = os.Getenv("SLACK_MESSAGE_STYLE")
+			if cfg.SlackMessageStyle == "" {
+				cfg.SlackMessageStyle = "blocks"
+			}
+			if cfg.SlackMessageStyle != "blocks" && cfg.SlackMessageStyle != "text" {
+				return nil, fmt.Errorf("invalid SLACK_MESSAGE_STYLE: %s. must be 'blocks' or 'text'", cfg.SlackMessageStyle)
+			}
+		case "discord":
+			cfg.DiscordWebhookURL = os.Getenv("DISCORD_WEBHOOK_URL")
+			if cfg.DiscordWebhookURL == "" {
+				return nil, fmt.Errorf("DISCORD_WEBHOOK_URL is required for discord platform")
+			}
+			cfg.DiscordSubMode = os.Getenv("DISCORD_SUB_MODE")
+			if cfg.DiscordSubMode == "" {
+				cfg.DiscordSubMode = "followup"
+			}
+			if cfg.DiscordSubMode != "followup" && cfg.DiscordSubMode != "thread" {
+				return nil, fmt.Errorf("invalid DISCORD_SUB_MODE: %s. must be 'followup' or 'thread' (thread requires a forum channel webhook)", cfg.DiscordSubMode)
+			}
+			cfg.DiscordTemplatePath = os.Getenv("DISCORD_TEMPLATE_PATH")
+			cfg.DiscordMessageStyle = os.Getenv("DISCORD_MESSAGE_STYLE")
+			if cfg.DiscordMessageStyle == "" {
+				cfg.DiscordMessageStyle = "embed"
+			}
+			if cfg.DiscordMessageStyle != "embed" && cfg.DiscordMessageStyle != "text" {
+				return nil, fmt.Errorf("invalid DISCORD_MESSAGE_STYLE: %s. must be 'embed' or 'text'", cfg.DiscordMessageStyle)
+			}
+		default:
+			return nil, fmt.Errorf("invalid TARGET_PLATFORM: %s. must be 'slack' or 'discord'", platform)
 		}
-	case "discord":
-		cfg.DiscordWebhookURL = os.Getenv("DISCORD_WEBHOOK_URL")
-		if cfg.DiscordWebhookURL == "" {
-			return nil, fmt.Errorf("DISCORD_WEBHOOK_URL is required for discord platform")
-		}
-	default:
-		return nil, fmt.Errorf("invalid TARGET_PLATFORM: %s. must be 'slack' or 'discord'", cfg.TargetPlatform)
+	}
+
+	cfg.PostFailurePolicy = os.Getenv("POST_FAILURE_POLICY")
+	if cfg.PostFailurePolicy == "" {
+		cfg.PostFailurePolicy = "any"
+	}
+	if cfg.PostFailurePolicy != "any" && cfg.PostFailurePolicy != "all" {
+		return nil, fmt.Errorf("invalid POST_FAILURE_POLICY: %s. must be 'any' or 'all'", cfg.PostFailurePolicy)
 	}
 
 	// --- 任意項目（デフォルト値あり） ---
 
+	cfg.VenueSelectStrategy = os.Getenv("VENUE_SELECT_STRATEGY")
+	if cfg.VenueSelectStrategy == "" {
+		cfg.VenueSelectStrategy = "random" // 戦略名は venueselector パッケージの登録内容で検証する (cmd/dailybot)
+	}
+
+	cfg.VenueCursorPath = os.Getenv("VENUE_CURSOR_PATH")
+	if cfg.VenueCursorPath == "" {
+		cfg.VenueCursorPath = "data/venue_cursor.json"
+	}
+
+	venueMaxAttemptsStr := os.Getenv("VENUE_MAX_ATTEMPTS")
+	if venueMaxAttemptsStr == "" {
+		cfg.VenueMaxAttempts = 3
+	} else {
+		cfg.VenueMaxAttempts, err = strconv.Atoi(venueMaxAttemptsStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse VENUE_MAX_ATTEMPTS: %w", err)
+		}
+		if cfg.VenueMaxAttempts < 1 {
+			return nil, fmt.Errorf("invalid VENUE_MAX_ATTEMPTS: %d. must be at least 1", cfg.VenueMaxAttempts)
+		}
+	}
+
 	cfg.SelectStrategy = os.Getenv("SELECT_STRATEGY")
 	if cfg.SelectStrategy == "" {
 		cfg.SelectStrategy = "random"
 	}
+	if !selector.IsRegistered(cfg.SelectStrategy) {
+		return nil, fmt.Errorf("invalid SELECT_STRATEGY: %s. must be one of %v", cfg.SelectStrategy, selector.Strategies())
+	}
+
+	cfg.InterestProfilePath = os.Getenv("INTEREST_PROFILE_PATH") // 読み込みと検証は selector を生成する側 (cmd/dailybot) で行う
+
+	selectTemperatureStr := os.Getenv("SELECT_TEMPERATURE")
+	if selectTemperatureStr == "" {
+		cfg.SelectTemperature = selector.DefaultTemperature
+	} else {
+		cfg.SelectTemperature, err = strconv.ParseFloat(selectTemperatureStr, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse SELECT_TEMPERATURE: %w", err)
+		}
+		if cfg.SelectTemperature < 0 {
+			return nil, fmt.Errorf("invalid SELECT_TEMPERATURE: %v. must not be negative", cfg.SelectTemperature)
+		}
+	}
+
+	scoreShortlistSizeStr := os.Getenv("SCORE_SHORTLIST_SIZE")
+	if scoreShortlistSizeStr == "" {
+		cfg.ScoreShortlistSize = selector.DefaultShortlistSize
+	} else {
+		cfg.ScoreShortlistSize, err = strconv.Atoi(scoreShortlistSizeStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse SCORE_SHORTLIST_SIZE: %w", err)
+		}
+		if cfg.ScoreShortlistSize < 1 {
+			return nil, fmt.Errorf("invalid SCORE_SHORTLIST_SIZE: %d. must be at least 1", cfg.ScoreShortlistSize)
+		}
+	}
 
 	abstractMaxCharsStr := os.Getenv("ABSTRACT_MAX_CHARS")
 	if abstractMaxCharsStr == "" {
@@ -111,6 +378,16 @@ func Load() (*Config, error) {
 		}
 	}
 
+	showReviewsStr := os.Getenv("SHOW_REVIEWS")
+	if showReviewsStr == "" {
+		cfg.ShowReviews = false
+	} else {
+		cfg.ShowReviews, err = strconv.ParseBool(showReviewsStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse SHOW_REVIEWS: %w", err)
+		}
+	}
+
 	dryRunStr := os.Getenv("DRY_RUN")
 	if dryRunStr == "" {
 		cfg.DryRun = false
@@ -121,6 +398,70 @@ func Load() (*Config, error) {
 		}
 	}
 
+	runTimeoutStr := os.Getenv("RUN_TIMEOUT")
+	if runTimeoutStr == "" {
+		cfg.RunTimeout = 5 * time.Minute
+	} else {
+		cfg.RunTimeout, err = time.ParseDuration(runTimeoutStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RUN_TIMEOUT: %w", err)
+		}
+		if cfg.RunTimeout <= 0 {
+			return nil, fmt.Errorf("RUN_TIMEOUT must be positive, got %s", cfg.RunTimeout)
+		}
+	}
+
+	cfg.ScheduleCron = os.Getenv("SCHEDULE_CRON")
+	if cfg.ScheduleCron == "" {
+		cfg.ScheduleCron = "0 9 * * *" // cron 式は serve / validate-config で検証する
+	}
+
+	scheduleTimezone := os.Getenv("SCHEDULE_TIMEZONE")
+	if scheduleTimezone == "" {
+		scheduleTimezone = "Asia/Tokyo"
+	}
+	cfg.ScheduleLocation, err = time.LoadLocation(scheduleTimezone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse SCHEDULE_TIMEZONE: %w", err)
+	}
+
+	retryMaxAttemptsStr := os.Getenv("RETRY_MAX_ATTEMPTS")
+	if retryMaxAttemptsStr == "" {
+		cfg.RetryMaxAttempts = 3
+	} else {
+		cfg.RetryMaxAttempts, err = strconv.Atoi(retryMaxAttemptsStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RETRY_MAX_ATTEMPTS: %w", err)
+		}
+		if cfg.RetryMaxAttempts < 1 {
+			return nil, fmt.Errorf("RETRY_MAX_ATTEMPTS must be at least 1, got %d", cfg.RetryMaxAttempts)
+		}
+	}
+
+	retryBaseDelayStr := os.Getenv("RETRY_BASE_DELAY")
+	if retryBaseDelayStr == "" {
+		cfg.RetryBaseDelay = time.Second
+	} else {
+		cfg.RetryBaseDelay, err = time.ParseDuration(retryBaseDelayStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RETRY_BASE_DELAY: %w", err)
+		}
+	}
+
+	retryMaxDelayStr := os.Getenv("RETRY_MAX_DELAY")
+	if retryMaxDelayStr == "" {
+		cfg.RetryMaxDelay = 30 * time.Second
+	} else {
+		cfg.RetryMaxDelay, err = time.ParseDuration(retryMaxDelayStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse RETRY_MAX_DELAY: %w", err)
+		}
+	}
+
+	cfg.HistoryPath = HistoryPath()
+
+	cfg.PaperID = strings.TrimSpace(os.Getenv("PAPER_ID"))
+
 	cfg.CustomUserAgent = os.Getenv("CUSTOM_USER_AGENT")
 	if cfg.CustomUserAgent == "" {
 		cfg.CustomUserAgent = "daily-paper-bot/1.0 (+https://github.com/hayashi-yaken/daily-paper-bot)"
@@ -129,6 +470,29 @@ func Load() (*Config, error) {
 	cfg.OpenReviewEmail = os.Getenv("OR_EMAIL")
 	cfg.OpenReviewPassword = os.Getenv("OR_PASSWORD")
 
+	pageSizeStr := os.Getenv("OR_PAGE_SIZE")
+	if pageSizeStr == "" {
+		cfg.OpenReviewPageSize = 1000
+	} else {
+		cfg.OpenReviewPageSize, err = strconv.Atoi(pageSizeStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse OR_PAGE_SIZE: %w", err)
+		}
+		if cfg.OpenReviewPageSize <= 0 || cfg.OpenReviewPageSize > 1000 {
+			return nil, fmt.Errorf("OR_PAGE_SIZE must be between 1 and 1000, got %d", cfg.OpenReviewPageSize)
+		}
+	}
+
+	maxNotesStr := os.Getenv("OR_MAX_NOTES")
+	if maxNotesStr == "" {
+		cfg.OpenReviewMaxNotes = 20000
+	} else {
+		cfg.OpenReviewMaxNotes, err = strconv.Atoi(maxNotesStr)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse OR_MAX_NOTES: %w", err)
+		}
+	}
+
 	// Translation
 	translateEnabledStr := os.Getenv("TRANSLATE_ENABLED")
 	if translateEnabledStr == "" {
@@ -140,6 +504,26 @@ func Load() (*Config, error) {
 		}
 	}
 
+	// TranslatorProvider (例: "azure" / "deepl,azure")
+	for _, provider := range strings.Split(os.Getenv("TRANSLATOR_PROVIDER"), ",") {
+		provider = strings.ToLower(strings.TrimSpace(provider))
+		if provider == "" || slices.Contains(cfg.TranslatorProviders, provider) {
+			continue
+		}
+		if !slices.Contains(translatorProviders, provider) {
+			return nil, fmt.Errorf("invalid TRANSLATOR_PROVIDER: %s. must be one of %v", provider, translatorProviders)
+		}
+		cfg.TranslatorProviders = append(cfg.TranslatorProviders, provider)
+	}
+	if len(cfg.TranslatorProviders) == 0 {
+		cfg.TranslatorProviders = []string{"azure"}
+	}
+
+	cfg.TranslationCachePath = os.Getenv("TRANSLATION_CACHE_PATH")
+	if cfg.TranslationCachePath == "" {
+		cfg.TranslationCachePath = "data/translations.json"
+	}
+
 	cfg.AzureTranslatorEndpoint = os.Getenv("AZURE_TRANSLATOR_ENDPOINT")
 	if cfg.AzureTranslatorEndpoint == "" {
 		cfg.AzureTranslatorEndpoint = "https://api.cognitive.microsofttranslator.com"
@@ -147,11 +531,71 @@ func Load() (*Config, error) {
 	cfg.AzureTranslatorRegion = os.Getenv("AZURE_TRANSLATOR_REGION")
 	cfg.AzureTranslatorKey = os.Getenv("AZURE_TRANSLATOR_KEY")
 
+	cfg.DeepLAPIKey = os.Getenv("DEEPL_API_KEY")
+	cfg.DeepLEndpoint = os.Getenv("DEEPL_ENDPOINT")
+	if cfg.DeepLEndpoint == "" {
+		cfg.DeepLEndpoint = "https://api.deepl.com"
+		if strings.HasSuffix(cfg.DeepLAPIKey, ":fx") { // Free プランのキー
+			cfg.DeepLEndpoint = "https://api-free.deepl.com"
+		}
+	}
+
+	cfg.GoogleTranslateAPIKey = os.Getenv("GOOGLE_TRANSLATE_API_KEY")
+	cfg.GoogleTranslateEndpoint = os.Getenv("GOOGLE_TRANSLATE_ENDPOINT")
+	if cfg.GoogleTranslateEndpoint == "" {
+		cfg.GoogleTranslateEndpoint = "https://translation.googleapis.com"
+	}
+
+	cfg.LibreTranslateEndpoint = os.Getenv("LIBRETRANSLATE_ENDPOINT")
+	cfg.LibreTranslateAPIKey = os.Getenv("LIBRETRANSLATE_API_KEY")
+
+	cfg.OpenAIAPIKey = os.Getenv("OPENAI_API_KEY")
+	cfg.OpenAIBaseURL = os.Getenv("OPENAI_BASE_URL")
+	if cfg.OpenAIBaseURL == "" {
+		cfg.OpenAIBaseURL = defaultOpenAIBaseURL
+	}
+	cfg.OpenAIModel = os.Getenv("OPENAI_MODEL")
+	if cfg.OpenAIModel == "" {
+		cfg.OpenAIModel = "gpt-4o-mini"
+	}
+
 	if cfg.TranslateEnabled {
-		if cfg.AzureTranslatorKey == "" || cfg.AzureTranslatorRegion == "" {
-			return nil, fmt.Errorf("AZURE_TRANSLATOR_KEY and AZURE_TRANSLATOR_REGION are required when TRANSLATE_ENABLED=true")
+		for _, provider := range cfg.TranslatorProviders {
+			if err := validateTranslator(cfg, provider); err != nil {
+				return nil, err
+			}
 		}
 	}
 
 	return cfg, nil
 }
+
+// defaultOpenAIBaseURL は OPENAI_BASE_URL のデフォルト値です。
+const defaultOpenAIBaseURL = "https://api.openai.com/v1"
+
+// validateTranslator は TRANSLATOR_PROVIDER で選んだ翻訳サービスの必須項目を検証します。
+func validateTranslator(cfg *Config, provider string) error {
+	switch provider {
+	case "azure":
+		if cfg.AzureTranslatorKey == "" || cfg.AzureTranslatorRegion == "" {
+			return fmt.Errorf("AZURE_TRANSLATOR_KEY and AZURE_TRANSLATOR_REGION are required when TRANSLATE_ENABLED=true")
+		}
+	case "deepl":
+		if cfg.DeepLAPIKey == "" {
+			return fmt.Errorf("DEEPL_API_KEY is required for TRANSLATOR_PROVIDER=deepl")
+		}
+	case "google":
+		if cfg.GoogleTranslateAPIKey == "" {
+			return fmt.Errorf("GOOGLE_TRANSLATE_API_KEY is required for TRANSLATOR_PROVIDER=google")
+		}
+	case "libretranslate":
+		if cfg.LibreTranslateEndpoint == "" {
+			return fmt.Errorf("LIBRETRANSLATE_ENDPOINT is required for TRANSLATOR_PROVIDER=libretranslate")
+		}
+	case "openai":
+		if cfg.OpenAIAPIKey == "" && cfg.OpenAIBaseURL == defaultOpenAIBaseURL {
+			return fmt.Errorf("OPENAI_API_KEY is required for TRANSLATOR_PROVIDER=openai unless OPENAI_BASE_URL is set")
+		}
+	}
+	return nil
+}
@@ -3,7 +3,9 @@ package config
 import (
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
+	"time"
 )
 
 // setupTestConfigFile はテスト用のvenues.jsonファイルを作成します
@@ -54,6 +56,48 @@ func TestLoad_Success_FromFile(t *testing.T) {
 	if cfg.Venues[0].Name != "ICLR" {
 		t.Errorf("expected venue name 'ICLR', got '%s'", cfg.Venues[0].Name)
 	}
+	if cfg.Venues[0].Status != "accepted" {
+		t.Errorf("expected default status 'accepted', got '%s'", cfg.Venues[0].Status)
+	}
+}
+
+func TestLoad_VenueStatus(t *testing.T) {
+	setBasicEnv := func() {
+		os.Setenv("TARGET_PLATFORM", "slack")
+		os.Setenv("SLACK_BOT_TOKEN", "test_token")
+		os.Setenv("SLACK_CHANNEL_ID", "test_channel")
+	}
+	unsetBasicEnv := func() {
+		os.Unsetenv("TARGET_PLATFORM")
+		os.Unsetenv("SLACK_BOT_TOKEN")
+		os.Unsetenv("SLACK_CHANNEL_ID")
+	}
+
+	t.Run("status is normalized", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025,"status":" Oral "}]`)
+		defer cleanup()
+		setBasicEnv()
+		defer unsetBasicEnv()
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.Venues[0].Status != "oral" {
+			t.Errorf("expected status 'oral', got '%s'", cfg.Venues[0].Status)
+		}
+	})
+
+	t.Run("invalid status fails", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025,"status":"ICLR.cc/2025/Conference"}]`)
+		defer cleanup()
+		setBasicEnv()
+		defer unsetBasicEnv()
+
+		if _, err := Load(); err == nil {
+			t.Error("expected error for invalid status")
+		}
+	})
 }
 
 func TestLoad_Failure_FileError(t *testing.T) {
@@ -252,3 +296,703 @@ func TestLoad_WithTranslator(t *testing.T) {
 		}
 	})
 }
+
+func TestLoad_TranslatorProvider(t *testing.T) {
+	cleanup := setupTestConfigFile(t, `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025}]`)
+	defer cleanup()
+	t.Setenv("TARGET_PLATFORM", "slack")
+	t.Setenv("SLACK_BOT_TOKEN", "test_token")
+	t.Setenv("SLACK_CHANNEL_ID", "test_channel")
+	t.Setenv("TRANSLATE_ENABLED", "true")
+	for _, key := range []string{
+		"TRANSLATOR_PROVIDER", "AZURE_TRANSLATOR_KEY", "AZURE_TRANSLATOR_REGION",
+		"DEEPL_API_KEY", "DEEPL_ENDPOINT", "GOOGLE_TRANSLATE_API_KEY", "GOOGLE_TRANSLATE_ENDPOINT",
+		"LIBRETRANSLATE_ENDPOINT", "LIBRETRANSLATE_API_KEY", "OPENAI_API_KEY", "OPENAI_BASE_URL", "OPENAI_MODEL",
+		"TRANSLATION_CACHE_PATH",
+	} {
+		t.Setenv(key, "")
+	}
+
+	t.Run("defaults to azure", func(t *testing.T) {
+		t.Setenv("AZURE_TRANSLATOR_KEY", "k")
+		t.Setenv("AZURE_TRANSLATOR_REGION", "japaneast")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if !slices.Equal(cfg.TranslatorProviders, []string{"azure"}) {
+			t.Errorf("expected providers [azure], got %v", cfg.TranslatorProviders)
+		}
+		if cfg.TranslationCachePath != "data/translations.json" {
+			t.Errorf("expected default cache path, got %q", cfg.TranslationCachePath)
+		}
+	})
+
+	t.Run("comma separated providers are parsed in order", func(t *testing.T) {
+		t.Setenv("TRANSLATOR_PROVIDER", "deepl, azure,deepl")
+		t.Setenv("DEEPL_API_KEY", "k")
+		t.Setenv("AZURE_TRANSLATOR_KEY", "k")
+		t.Setenv("AZURE_TRANSLATOR_REGION", "japaneast")
+		t.Setenv("TRANSLATION_CACHE_PATH", "cache/translations.json")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if !slices.Equal(cfg.TranslatorProviders, []string{"deepl", "azure"}) {
+			t.Errorf("expected providers [deepl azure], got %v", cfg.TranslatorProviders)
+		}
+		if cfg.TranslationCachePath != "cache/translations.json" {
+			t.Errorf("expected custom cache path, got %q", cfg.TranslationCachePath)
+		}
+	})
+
+	t.Run("every provider in the chain needs its settings", func(t *testing.T) {
+		t.Setenv("TRANSLATOR_PROVIDER", "deepl,google")
+		t.Setenv("DEEPL_API_KEY", "k")
+		if _, err := Load(); err == nil {
+			t.Error("expected error when GOOGLE_TRANSLATE_API_KEY is missing")
+		}
+	})
+
+	t.Run("deepl free key selects the free endpoint", func(t *testing.T) {
+		t.Setenv("TRANSLATOR_PROVIDER", "DeepL")
+		t.Setenv("DEEPL_API_KEY", "k:fx")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if !slices.Equal(cfg.TranslatorProviders, []string{"deepl"}) {
+			t.Errorf("expected providers [deepl], got %v", cfg.TranslatorProviders)
+		}
+		if cfg.DeepLEndpoint != "https://api-free.deepl.com" {
+			t.Errorf("expected free endpoint, got %q", cfg.DeepLEndpoint)
+		}
+	})
+
+	t.Run("deepl pro key selects the pro endpoint", func(t *testing.T) {
+		t.Setenv("TRANSLATOR_PROVIDER", "deepl")
+		t.Setenv("DEEPL_API_KEY", "k")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.DeepLEndpoint != "https://api.deepl.com" {
+			t.Errorf("expected pro endpoint, got %q", cfg.DeepLEndpoint)
+		}
+	})
+
+	t.Run("openai defaults", func(t *testing.T) {
+		t.Setenv("TRANSLATOR_PROVIDER", "openai")
+		t.Setenv("OPENAI_API_KEY", "k")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.OpenAIBaseURL != "https://api.openai.com/v1" || cfg.OpenAIModel != "gpt-4o-mini" {
+			t.Errorf("unexpected openai defaults: base=%q model=%q", cfg.OpenAIBaseURL, cfg.OpenAIModel)
+		}
+	})
+
+	t.Run("openai compatible server without key", func(t *testing.T) {
+		t.Setenv("TRANSLATOR_PROVIDER", "openai")
+		t.Setenv("OPENAI_BASE_URL", "http://localhost:11434/v1")
+		if _, err := Load(); err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+	})
+
+	tests := []struct {
+		name     string
+		provider string
+	}{
+		{"deepl requires key", "deepl"},
+		{"google requires key", "google"},
+		{"libretranslate requires endpoint", "libretranslate"},
+		{"openai requires key for the default endpoint", "openai"},
+		{"unknown provider fails", "papago"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TRANSLATOR_PROVIDER", tt.provider)
+			if _, err := Load(); err == nil {
+				t.Errorf("expected error for TRANSLATOR_PROVIDER=%s without its settings", tt.provider)
+			}
+		})
+	}
+}
+
+func TestLoad_SelectStrategy(t *testing.T) {
+	jsonContent := `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025}]`
+
+	setBasicEnv := func() {
+		os.Setenv("TARGET_PLATFORM", "slack")
+		os.Setenv("SLACK_BOT_TOKEN", "test_token")
+		os.Setenv("SLACK_CHANNEL_ID", "test_channel")
+	}
+	unsetEnv := func() {
+		os.Unsetenv("TARGET_PLATFORM")
+		os.Unsetenv("SLACK_BOT_TOKEN")
+		os.Unsetenv("SLACK_CHANNEL_ID")
+		os.Unsetenv("SELECT_STRATEGY")
+	}
+
+	t.Run("defaults to random", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, jsonContent)
+		defer cleanup()
+		setBasicEnv()
+		defer unsetEnv()
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.SelectStrategy != "random" {
+			t.Errorf("expected SelectStrategy 'random', got '%s'", cfg.SelectStrategy)
+		}
+	})
+
+	t.Run("registered strategy is accepted", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, jsonContent)
+		defer cleanup()
+		setBasicEnv()
+		os.Setenv("SELECT_STRATEGY", "deterministic-daily")
+		defer unsetEnv()
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.SelectStrategy != "deterministic-daily" {
+			t.Errorf("expected SelectStrategy 'deterministic-daily', got '%s'", cfg.SelectStrategy)
+		}
+	})
+
+	t.Run("unknown strategy fails", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, jsonContent)
+		defer cleanup()
+		setBasicEnv()
+		os.Setenv("SELECT_STRATEGY", "best-paper")
+		defer unsetEnv()
+
+		if _, err := Load(); err == nil {
+			t.Error("expected error for unknown SELECT_STRATEGY")
+		}
+	})
+}
+
+func TestLoad_MultipleTargetPlatforms(t *testing.T) {
+	jsonContent := `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025}]`
+	unsetEnv := func() {
+		os.Unsetenv("TARGET_PLATFORM")
+		os.Unsetenv("SLACK_BOT_TOKEN")
+		os.Unsetenv("SLACK_CHANNEL_ID")
+		os.Unsetenv("DISCORD_WEBHOOK_URL")
+		os.Unsetenv("POST_FAILURE_POLICY")
+	}
+
+	t.Run("comma separated list is parsed and deduplicated", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, jsonContent)
+		defer cleanup()
+		os.Setenv("TARGET_PLATFORM", "slack, discord,slack")
+		os.Setenv("SLACK_BOT_TOKEN", "test_token")
+		os.Setenv("SLACK_CHANNEL_ID", "test_channel")
+		os.Setenv("DISCORD_WEBHOOK_URL", "https://example.com/webhook")
+		defer unsetEnv()
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if len(cfg.TargetPlatforms) != 2 || cfg.TargetPlatforms[0] != "slack" || cfg.TargetPlatforms[1] != "discord" {
+			t.Errorf("expected [slack discord], got %v", cfg.TargetPlatforms)
+		}
+		if cfg.PostFailurePolicy != "any" {
+			t.Errorf("expected default PostFailurePolicy 'any', got '%s'", cfg.PostFailurePolicy)
+		}
+	})
+
+	t.Run("credentials are required for every platform", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, jsonContent)
+		defer cleanup()
+		os.Setenv("TARGET_PLATFORM", "slack,discord")
+		os.Setenv("SLACK_BOT_TOKEN", "test_token")
+		os.Setenv("SLACK_CHANNEL_ID", "test_channel")
+		defer unsetEnv()
+
+		if _, err := Load(); err == nil {
+			t.Error("expected error when DISCORD_WEBHOOK_URL is missing")
+		}
+	})
+
+	t.Run("message styles default to rich layouts", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, jsonContent)
+		defer cleanup()
+		t.Setenv("TARGET_PLATFORM", "slack,discord")
+		t.Setenv("SLACK_BOT_TOKEN", "test_token")
+		t.Setenv("SLACK_CHANNEL_ID", "test_channel")
+		t.Setenv("DISCORD_WEBHOOK_URL", "https://discord.com/api/webhooks/x")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.SlackMessageStyle != "blocks" || cfg.DiscordMessageStyle != "embed" {
+			t.Errorf("expected blocks/embed, got %q/%q", cfg.SlackMessageStyle, cfg.DiscordMessageStyle)
+		}
+	})
+
+	t.Run("text message style", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, jsonContent)
+		defer cleanup()
+		t.Setenv("TARGET_PLATFORM", "slack,discord")
+		t.Setenv("SLACK_BOT_TOKEN", "test_token")
+		t.Setenv("SLACK_CHANNEL_ID", "test_channel")
+		t.Setenv("DISCORD_WEBHOOK_URL", "https://discord.com/api/webhooks/x")
+		t.Setenv("SLACK_MESSAGE_STYLE", "text")
+		t.Setenv("DISCORD_MESSAGE_STYLE", "text")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.SlackMessageStyle != "text" || cfg.DiscordMessageStyle != "text" {
+			t.Errorf("expected text/text, got %q/%q", cfg.SlackMessageStyle, cfg.DiscordMessageStyle)
+		}
+	})
+
+	t.Run("invalid message style fails", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, jsonContent)
+		defer cleanup()
+		t.Setenv("TARGET_PLATFORM", "discord")
+		t.Setenv("DISCORD_WEBHOOK_URL", "https://discord.com/api/webhooks/x")
+		t.Setenv("DISCORD_MESSAGE_STYLE", "blocks")
+		if _, err := Load(); err == nil {
+			t.Error("expected error for invalid DISCORD_MESSAGE_STYLE")
+		}
+	})
+
+	t.Run("unknown platform in list fails", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, jsonContent)
+		defer cleanup()
+		os.Setenv("TARGET_PLATFORM", "slack,teams")
+		os.Setenv("SLACK_BOT_TOKEN", "test_token")
+		os.Setenv("SLACK_CHANNEL_ID", "test_channel")
+		defer unsetEnv()
+
+		if _, err := Load(); err == nil {
+			t.Error("expected error for unknown platform")
+		}
+	})
+
+	t.Run("invalid failure policy fails", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, jsonContent)
+		defer cleanup()
+		os.Setenv("TARGET_PLATFORM", "slack")
+		os.Setenv("SLACK_BOT_TOKEN", "test_token")
+		os.Setenv("SLACK_CHANNEL_ID", "test_channel")
+		os.Setenv("POST_FAILURE_POLICY", "never")
+		defer unsetEnv()
+
+		if _, err := Load(); err == nil {
+			t.Error("expected error for invalid POST_FAILURE_POLICY")
+		}
+	})
+}
+
+func TestLoad_VenueSelectStrategy(t *testing.T) {
+	setBasicEnv := func() {
+		os.Setenv("TARGET_PLATFORM", "slack")
+		os.Setenv("SLACK_BOT_TOKEN", "test_token")
+		os.Setenv("SLACK_CHANNEL_ID", "test_channel")
+	}
+	unsetEnv := func() {
+		os.Unsetenv("TARGET_PLATFORM")
+		os.Unsetenv("SLACK_BOT_TOKEN")
+		os.Unsetenv("SLACK_CHANNEL_ID")
+		os.Unsetenv("VENUE_SELECT_STRATEGY")
+		os.Unsetenv("VENUE_CURSOR_PATH")
+		os.Unsetenv("VENUE_MAX_ATTEMPTS")
+	}
+
+	t.Run("defaults to random with default cursor path", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025}]`)
+		defer cleanup()
+		setBasicEnv()
+		defer unsetEnv()
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.VenueSelectStrategy != "random" {
+			t.Errorf("expected VenueSelectStrategy 'random', got '%s'", cfg.VenueSelectStrategy)
+		}
+		if cfg.VenueCursorPath != "data/venue_cursor.json" {
+			t.Errorf("unexpected VenueCursorPath: %s", cfg.VenueCursorPath)
+		}
+		if cfg.Venues[0].Weight != 0 {
+			t.Errorf("expected unset weight to be 0, got %v", cfg.Venues[0].Weight)
+		}
+		if cfg.VenueMaxAttempts != 3 {
+			t.Errorf("expected VenueMaxAttempts 3, got %d", cfg.VenueMaxAttempts)
+		}
+	})
+
+	t.Run("custom max attempts", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025}]`)
+		defer cleanup()
+		setBasicEnv()
+		os.Setenv("VENUE_MAX_ATTEMPTS", "5")
+		defer unsetEnv()
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.VenueMaxAttempts != 5 {
+			t.Errorf("expected VenueMaxAttempts 5, got %d", cfg.VenueMaxAttempts)
+		}
+	})
+
+	t.Run("zero max attempts fails", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025}]`)
+		defer cleanup()
+		setBasicEnv()
+		os.Setenv("VENUE_MAX_ATTEMPTS", "0")
+		defer unsetEnv()
+
+		if _, err := Load(); err == nil {
+			t.Error("expected error for VENUE_MAX_ATTEMPTS=0")
+		}
+	})
+
+	t.Run("weighted strategy with weights", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, `[
+			{"name":"NeurIPS","venue":"NeurIPS.cc/2025/Conference","year":2025,"weight":5},
+			{"name":"WS","venue":"WS/2025/Workshop","year":2025,"weight":0.5}
+		]`)
+		defer cleanup()
+		setBasicEnv()
+		os.Setenv("VENUE_SELECT_STRATEGY", "weighted")
+		defer unsetEnv()
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.VenueSelectStrategy != "weighted" {
+			t.Errorf("expected VenueSelectStrategy 'weighted', got '%s'", cfg.VenueSelectStrategy)
+		}
+		if cfg.Venues[0].Weight != 5 || cfg.Venues[1].Weight != 0.5 {
+			t.Errorf("unexpected weights: %v, %v", cfg.Venues[0].Weight, cfg.Venues[1].Weight)
+		}
+	})
+
+	t.Run("negative weight fails", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025,"weight":-1}]`)
+		defer cleanup()
+		setBasicEnv()
+		defer unsetEnv()
+
+		if _, err := Load(); err == nil {
+			t.Error("expected error for negative weight")
+		}
+	})
+}
+
+func TestLoad_Schedule(t *testing.T) {
+	jsonContent := `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025}]`
+	setBasicEnv := func() {
+		os.Setenv("TARGET_PLATFORM", "slack")
+		os.Setenv("SLACK_BOT_TOKEN", "test_token")
+		os.Setenv("SLACK_CHANNEL_ID", "test_channel")
+	}
+	unsetEnv := func() {
+		os.Unsetenv("TARGET_PLATFORM")
+		os.Unsetenv("SLACK_BOT_TOKEN")
+		os.Unsetenv("SLACK_CHANNEL_ID")
+		os.Unsetenv("SCHEDULE_CRON")
+		os.Unsetenv("SCHEDULE_TIMEZONE")
+	}
+
+	t.Run("defaults to 09:00 in Asia/Tokyo", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, jsonContent)
+		defer cleanup()
+		setBasicEnv()
+		defer unsetEnv()
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.ScheduleCron != "0 9 * * *" {
+			t.Errorf("unexpected ScheduleCron: %s", cfg.ScheduleCron)
+		}
+		if cfg.ScheduleLocation.String() != "Asia/Tokyo" {
+			t.Errorf("unexpected ScheduleLocation: %s", cfg.ScheduleLocation)
+		}
+	})
+
+	t.Run("custom schedule", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, jsonContent)
+		defer cleanup()
+		setBasicEnv()
+		os.Setenv("SCHEDULE_CRON", "30 8 * * 1-5")
+		os.Setenv("SCHEDULE_TIMEZONE", "UTC")
+		defer unsetEnv()
+
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.ScheduleCron != "30 8 * * 1-5" || cfg.ScheduleLocation != time.UTC {
+			t.Errorf("unexpected schedule: %s %s", cfg.ScheduleCron, cfg.ScheduleLocation)
+		}
+	})
+
+	t.Run("invalid timezone fails", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, jsonContent)
+		defer cleanup()
+		setBasicEnv()
+		os.Setenv("SCHEDULE_TIMEZONE", "Mars/Olympus")
+		defer unsetEnv()
+
+		if _, err := Load(); err == nil {
+			t.Error("expected error for invalid SCHEDULE_TIMEZONE")
+		}
+	})
+}
+
+func TestLoadVenues(t *testing.T) {
+	t.Run("does not require environment variables", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025,"weight":2}]`)
+		defer cleanup()
+
+		venues, err := LoadVenues()
+		if err != nil {
+			t.Fatalf("LoadVenues() failed: %v", err)
+		}
+		if len(venues) != 1 || venues[0].Status != "accepted" || venues[0].Weight != 2 {
+			t.Errorf("unexpected venues: %+v", venues)
+		}
+	})
+
+	t.Run("type defaults to openreview", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025},{"name":"cs.CL","venue":"cat:cs.CL","type":"arXiv","window_days":3}]`)
+		defer cleanup()
+
+		venues, err := LoadVenues()
+		if err != nil {
+			t.Fatalf("LoadVenues() failed: %v", err)
+		}
+		if venues[0].Type != SourceOpenReview {
+			t.Errorf("expected type openreview, got %q", venues[0].Type)
+		}
+		if venues[1].Type != SourceArXiv || venues[1].WindowDays != 3 {
+			t.Errorf("unexpected arxiv venue: %+v", venues[1])
+		}
+	})
+
+	t.Run("reading list types", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, `[{"name":"Reading group","venue":"data/reading.bib","type":"bibtex"},{"name":"Backlog","venue":"data/backlog.csv","type":"CSV"}]`)
+		defer cleanup()
+
+		venues, err := LoadVenues()
+		if err != nil {
+			t.Fatalf("LoadVenues() failed: %v", err)
+		}
+		if venues[0].Type != SourceBibTeX || venues[1].Type != SourceCSV {
+			t.Errorf("unexpected types: %q, %q", venues[0].Type, venues[1].Type)
+		}
+	})
+
+	t.Run("unknown type fails", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, `[{"name":"X","venue":"x","year":2025,"type":"semantic-scholar"}]`)
+		defer cleanup()
+
+		if _, err := LoadVenues(); err == nil {
+			t.Error("expected error for unknown type")
+		}
+	})
+
+	t.Run("empty list fails", func(t *testing.T) {
+		cleanup := setupTestConfigFile(t, `[]`)
+		defer cleanup()
+
+		if _, err := LoadVenues(); err == nil {
+			t.Error("expected error for empty venues")
+		}
+	})
+}
+
+func TestHistoryPath(t *testing.T) {
+	t.Run("defaults to data/posted.json", func(t *testing.T) {
+		t.Setenv("HISTORY_PATH", "")
+		if got := HistoryPath(); got != "data/posted.json" {
+			t.Errorf("expected data/posted.json, got %q", got)
+		}
+	})
+
+	t.Run("HISTORY_PATH overrides the default", func(t *testing.T) {
+		t.Setenv("HISTORY_PATH", "tmp/history.json")
+		if got := HistoryPath(); got != "tmp/history.json" {
+			t.Errorf("expected tmp/history.json, got %q", got)
+		}
+	})
+}
+
+func TestVenueFromID(t *testing.T) {
+	tests := []struct {
+		venueID string
+		want    VenueConfig
+	}{
+		{"ICLR.cc/2025/Conference", VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025, Status: "accepted"}},
+		{"ICLR.cc/2024/Conference/Rejected_Submission", VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2024/Conference", Year: 2024, Status: "all"}},
+		{"NeurIPS.cc/2024/Datasets_and_Benchmarks_Track", VenueConfig{Name: "NeurIPS", Venue: "NeurIPS.cc/2024/Datasets_and_Benchmarks_Track", Year: 2024, Status: "accepted"}},
+		{"colmweb.org/COLM/2024/Conference", VenueConfig{Name: "COLM", Venue: "colmweb.org/COLM/2024/Conference", Year: 2024, Status: "accepted"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.venueID, func(t *testing.T) {
+			got, err := VenueFromID(tt.venueID)
+			if err != nil {
+				t.Fatalf("VenueFromID() failed: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+
+	t.Run("venueid without year fails", func(t *testing.T) {
+		if _, err := VenueFromID("TMLR"); err == nil {
+			t.Error("expected error for venueid without year")
+		}
+	})
+}
+
+func TestLoad_ShowReviews(t *testing.T) {
+	cleanup := setupTestConfigFile(t, `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025}]`)
+	defer cleanup()
+	t.Setenv("TARGET_PLATFORM", "slack")
+	t.Setenv("SLACK_BOT_TOKEN", "test_token")
+	t.Setenv("SLACK_CHANNEL_ID", "test_channel")
+
+	t.Run("disabled by default", func(t *testing.T) {
+		t.Setenv("SHOW_REVIEWS", "")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if cfg.ShowReviews {
+			t.Error("expected ShowReviews to be false by default")
+		}
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Setenv("SHOW_REVIEWS", "true")
+		cfg, err := Load()
+		if err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if !cfg.ShowReviews {
+			t.Error("expected ShowReviews to be true")
+		}
+	})
+
+	t.Run("invalid value fails", func(t *testing.T) {
+		t.Setenv("SHOW_REVIEWS", "maybe")
+		if _, err := Load(); err == nil {
+			t.Error("expected error for invalid SHOW_REVIEWS")
+		}
+	})
+}
+
+func TestLoad_SelectTemperature(t *testing.T) {
+	cleanup := setupTestConfigFile(t, `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025}]`)
+	defer cleanup()
+	t.Setenv("TARGET_PLATFORM", "slack")
+	t.Setenv("SLACK_BOT_TOKEN", "test_token")
+	t.Setenv("SLACK_CHANNEL_ID", "test_channel")
+
+	tests := []struct {
+		value   string
+		want    float64
+		wantErr bool
+	}{
+		{value: "", want: 1},
+		{value: "0", want: 0},
+		{value: "2.5", want: 2.5},
+		{value: "-1", wantErr: true},
+		{value: "hot", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run("SELECT_TEMPERATURE="+tt.value, func(t *testing.T) {
+			t.Setenv("SELECT_TEMPERATURE", tt.value)
+			cfg, err := Load()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() failed: %v", err)
+			}
+			if cfg.SelectTemperature != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, cfg.SelectTemperature)
+			}
+		})
+	}
+}
+
+func TestLoad_ScoreShortlistSize(t *testing.T) {
+	cleanup := setupTestConfigFile(t, `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025}]`)
+	defer cleanup()
+	t.Setenv("TARGET_PLATFORM", "slack")
+	t.Setenv("SLACK_BOT_TOKEN", "test_token")
+	t.Setenv("SLACK_CHANNEL_ID", "test_channel")
+
+	tests := []struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{value: "", want: 30},
+		{value: "5", want: 5},
+		{value: "0", wantErr: true},
+		{value: "many", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run("SCORE_SHORTLIST_SIZE="+tt.value, func(t *testing.T) {
+			t.Setenv("SCORE_SHORTLIST_SIZE", tt.value)
+			cfg, err := Load()
+			if tt.wantErr {
+				if err == nil {
+					t.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Load() failed: %v", err)
+			}
+			if cfg.ScoreShortlistSize != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, cfg.ScoreShortlistSize)
+			}
+		})
+	}
+}
+
+func TestLoad_InterestProfilePath(t *testing.T) {
+	cleanup := setupTestConfigFile(t, `[{"name":"ICLR","venue":"ICLR.cc/2025/Conference","year":2025}]`)
+	defer cleanup()
+	t.Setenv("TARGET_PLATFORM", "slack")
+	t.Setenv("SLACK_BOT_TOKEN", "test_token")
+	t.Setenv("SLACK_CHANNEL_ID", "test_channel")
+	t.Setenv("INTEREST_PROFILE_PATH", "interests.json")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if cfg.InterestProfilePath != "interests.json" {
+		t.Errorf("unexpected InterestProfilePath: %q", cfg.InterestProfilePath)
+	}
+}
@@ -0,0 +1,157 @@
+package formatter
+
+import (
+	"fmt"
+	"hash/fnv"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+)
+
+// Discord embed の各要素の文字数上限です。
+const (
+	discordEmbedTitleMaxChars       = 256
+	discordEmbedDescriptionMaxChars = 4096
+	discordEmbedAuthorMaxChars      = 256
+	discordEmbedFooterMaxChars      = 2048
+	discordEmbedFieldValueMaxChars  = 1024
+	discordEmbedTotalMaxChars       = 6000 // title・description・fields・footer・author の合計。超えると Webhook が 400 を返す
+)
+
+// DiscordEmbed は Discord Webhook の embed オブジェクトです。
+type DiscordEmbed struct {
+	Title       string              `json:"title,omitempty"`
+	URL         string              `json:"url,omitempty"`
+	Description string              `json:"description,omitempty"`
+	Color       int                 `json:"color,omitempty"`
+	Author      *DiscordEmbedAuthor `json:"author,omitempty"`
+	Fields      []DiscordEmbedField `json:"fields,omitempty"`
+	Footer      *DiscordEmbedFooter `json:"footer,omitempty"`
+}
+
+// DiscordEmbedAuthor は embed の author 欄です。
+type DiscordEmbedAuthor struct {
+	Name string `json:"name"`
+}
+
+// DiscordEmbedField は embed の name/value フィールドです。
+type DiscordEmbedField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// DiscordEmbedFooter は embed の footer 欄です。
+type DiscordEmbedFooter struct {
+	Text string `json:"text"`
+}
+
+// venuePalette は VenueConfig.Color が未指定の場合に学会名から選ぶ色の一覧です。
+var venuePalette = []int{0x5865F2, 0x57F287, 0xFEE75C, 0xEB459E, 0xED4245, 0x3BA55C, 0xFAA61A, 0x9B59B6}
+
+// discordEmbed は論文情報を Discord embed に変換します。
+// タイトルはフォーラムへのリンク、著者は author 欄、Abstract は description、TL;DR・キーワード・採否・評価・PDF は fields、見出しと ID は footer に入ります。
+// 見出しと Abstract 欄はテンプレートの "header" / "abstract" で出力します。
+func discordEmbed(tmpl *messageTemplate, data TemplateData, abstractMaxChars int) DiscordEmbed {
+	paper := data.Paper
+	embed := DiscordEmbed{
+		Title: truncateWithin(paper.Title, discordEmbedTitleMaxChars),
+		URL:   paper.URL,
+		Color: venueColor(data.Venue),
+		Footer: &DiscordEmbedFooter{
+			Text: truncateWithin(fmt.Sprintf("%s · ID: %s", tmpl.render(templateHeader, data), paper.ID), discordEmbedFooterMaxChars),
+		},
+	}
+
+	if authors := joinAuthorsWithin(paper.Authors, discordEmbedAuthorMaxChars); authors != "" {
+		embed.Author = &DiscordEmbedAuthor{Name: authors}
+	}
+	if data.Abstract != "" || data.JaAbstract != "" {
+		abs := tmpl.render(templateAbstract, withAbstractMax(data, abstractMaxChars))
+		embed.Description = truncateWithin(abs, discordEmbedDescriptionMaxChars)
+	}
+	if data.TLDR != "" {
+		embed.Fields = append(embed.Fields, DiscordEmbedField{Name: "TL;DR", Value: truncateWithin(data.TLDR, discordEmbedFieldValueMaxChars)})
+	}
+	if data.Keywords != "" {
+		embed.Fields = append(embed.Fields, DiscordEmbedField{Name: "Keywords", Value: data.Keywords})
+	}
+	if r := data.Reviews; r != nil {
+		if r.Decision != "" {
+			embed.Fields = append(embed.Fields, DiscordEmbedField{Name: "Decision", Value: truncateWithin(r.Decision, discordEmbedFieldValueMaxChars), Inline: true})
+		}
+		if rating := ratingSummary(r); rating != "" {
+			embed.Fields = append(embed.Fields, DiscordEmbedField{Name: "Rating", Value: rating, Inline: true})
+		}
+	}
+	if pdf := paper.PDFURL; pdf != "" {
+		embed.Fields = append(embed.Fields, DiscordEmbedField{Name: "PDF", Value: truncateWithin(pdf, discordEmbedFieldValueMaxChars)})
+	}
+	return fitEmbed(embed)
+}
+
+// embedLength は Discord が embed の合計文字数として数える title・description・fields・footer・author のルーン数を返します。
+func embedLength(e DiscordEmbed) int {
+	n := runeLen(e.Title) + runeLen(e.Description)
+	for _, f := range e.Fields {
+		n += runeLen(f.Name) + runeLen(f.Value)
+	}
+	if e.Footer != nil {
+		n += runeLen(e.Footer.Text)
+	}
+	if e.Author != nil {
+		n += runeLen(e.Author.Name)
+	}
+	return n
+}
+
+// fitEmbed は embed の合計文字数が discordEmbedTotalMaxChars 以内に収まるよう、
+// description (Abstract) を minShrunkAbstractChars まで縮め → 長いフィールドから順に落とし → 最後に description をさらに縮めます。
+func fitEmbed(e DiscordEmbed) DiscordEmbed {
+	over := embedLength(e) - discordEmbedTotalMaxChars
+	if over <= 0 {
+		return e
+	}
+
+	// 1. description を縮める
+	if n := runeLen(e.Description); n > minShrunkAbstractChars {
+		e.Description = truncateWithin(e.Description, max(n-over, minShrunkAbstractChars))
+	}
+
+	// 2. 長いフィールドから落とす
+	e.Fields = slices.Clone(e.Fields)
+	for len(e.Fields) > 0 && embedLength(e) > discordEmbedTotalMaxChars {
+		longest := 0
+		for i, f := range e.Fields {
+			if runeLen(f.Name)+runeLen(f.Value) >= runeLen(e.Fields[longest].Name)+runeLen(e.Fields[longest].Value) {
+				longest = i
+			}
+		}
+		e.Fields = slices.Delete(e.Fields, longest, longest+1)
+	}
+
+	// 3. 最終手段として description をさらに縮める
+	if over := embedLength(e) - discordEmbedTotalMaxChars; over > 0 {
+		if keep := runeLen(e.Description) - over; keep > 0 {
+			e.Description = truncateWithin(e.Description, keep)
+		} else {
+			e.Description = ""
+		}
+	}
+	return e
+}
+
+// venueColor は VenueConfig.Color ("#RRGGBB") を数値に変換します。
+// 未指定または不正な場合は学会名のハッシュからパレットの色を選びます。
+func venueColor(venue config.VenueConfig) int {
+	if venue.Color != "" {
+		if c, err := strconv.ParseInt(strings.TrimPrefix(venue.Color, "#"), 16, 32); err == nil {
+			return int(c)
+		}
+	}
+	h := fnv.New32a()
+	h.Write([]byte(venue.Name))
+	return venuePalette[h.Sum32()%uint32(len(venuePalette))]
+}
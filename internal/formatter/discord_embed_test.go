@@ -0,0 +1,172 @@
+package formatter
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+)
+
+func TestDiscordFormatter_Embed(t *testing.T) {
+	venue := config.VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025, Color: "#FF8800"}
+	p := &paper.Paper{
+		ID:       "PID",
+		URL:      "https://openreview.net/forum?id=PID",
+		Title:    "A Paper",
+		Authors:  []string{"Alice", "Bob"},
+		Abstract: "english abstract",
+		PDFURL:   "https://openreview.net/pdf?id=PID",
+	}
+
+	t.Run("embed fields", func(t *testing.T) {
+		msg := NewDiscordFormatter().Format(p, venue, 100, "")
+		if len(msg.Embeds) != 1 {
+			t.Fatalf("expected 1 embed, got %d", len(msg.Embeds))
+		}
+		e := msg.Embeds[0]
+		if e.Title != "A Paper" || e.URL != "https://openreview.net/forum?id=PID" {
+			t.Errorf("unexpected title/url: %q %q", e.Title, e.URL)
+		}
+		if e.Author == nil || e.Author.Name != "Alice, Bob" {
+			t.Errorf("unexpected author: %+v", e.Author)
+		}
+		if e.Description != "*Abstract*:\nenglish abstract" {
+			t.Errorf("unexpected description: %q", e.Description)
+		}
+		if e.Footer == nil || !strings.Contains(e.Footer.Text, "ICLR 2025") || !strings.Contains(e.Footer.Text, "PID") {
+			t.Errorf("unexpected footer: %+v", e.Footer)
+		}
+		if e.Color != 0xFF8800 {
+			t.Errorf("expected color 0xFF8800, got %#x", e.Color)
+		}
+		if len(e.Fields) != 1 || e.Fields[0].Value != "https://openreview.net/pdf?id=PID" {
+			t.Errorf("unexpected fields: %+v", e.Fields)
+		}
+		if msg.Title != "A Paper" {
+			t.Errorf("expected Title 'A Paper', got %q", msg.Title)
+		}
+	})
+
+	t.Run("tldr and keywords fields", func(t *testing.T) {
+		rich := *p
+		rich.TLDR = "Short summary."
+		rich.Keywords = []string{"rl", "vision"}
+		e := NewDiscordFormatter().Format(&rich, venue, 100, "").Embeds[0]
+		if len(e.Fields) != 3 {
+			t.Fatalf("expected 3 fields, got %+v", e.Fields)
+		}
+		if e.Fields[0] != (DiscordEmbedField{Name: "TL;DR", Value: "Short summary."}) {
+			t.Errorf("unexpected TL;DR field: %+v", e.Fields[0])
+		}
+		if e.Fields[1] != (DiscordEmbedField{Name: "Keywords", Value: "rl, vision"}) {
+			t.Errorf("unexpected keywords field: %+v", e.Fields[1])
+		}
+	})
+
+	t.Run("reviews are inline fields before PDF", func(t *testing.T) {
+		reviewed := *p
+		reviewed.Reviews = &paper.Reviews{Decision: "Accept (Oral)", NumReviews: 2, Ratings: []float64{8, 7}}
+		e := NewDiscordFormatter().Format(&reviewed, venue, 100, "").Embeds[0]
+		if len(e.Fields) != 3 {
+			t.Fatalf("expected 3 fields, got %+v", e.Fields)
+		}
+		if e.Fields[0] != (DiscordEmbedField{Name: "Decision", Value: "Accept (Oral)", Inline: true}) {
+			t.Errorf("unexpected decision field: %+v", e.Fields[0])
+		}
+		if e.Fields[1] != (DiscordEmbedField{Name: "Rating", Value: "7.5 (2 reviews)", Inline: true}) {
+			t.Errorf("unexpected rating field: %+v", e.Fields[1])
+		}
+	})
+
+	t.Run("translated abstract goes to description and original to Sub", func(t *testing.T) {
+		msg := NewDiscordFormatter().Format(p, venue, 100, "日本語訳")
+		if msg.Embeds[0].Description != "*Abstract (日本語)*:\n日本語訳" {
+			t.Errorf("unexpected description: %q", msg.Embeds[0].Description)
+		}
+		if msg.Sub != "*Original Abstract*:\nenglish abstract" {
+			t.Errorf("unexpected Sub: %q", msg.Sub)
+		}
+	})
+
+	t.Run("color falls back to palette by venue name", func(t *testing.T) {
+		noColor := venue
+		noColor.Color = ""
+		first := NewDiscordFormatter().Format(p, noColor, 100, "").Embeds[0].Color
+		second := NewDiscordFormatter().Format(p, noColor, 100, "").Embeds[0].Color
+		if first == 0 || first != second {
+			t.Errorf("expected stable non-zero palette color, got %#x and %#x", first, second)
+		}
+	})
+
+	t.Run("long title is truncated to embed limit", func(t *testing.T) {
+		long := *p
+		long.Title = strings.Repeat("あ", 300)
+		e := NewDiscordFormatter().Format(&long, venue, 100, "").Embeds[0]
+		if n := len([]rune(e.Title)); n > 256+3 {
+			t.Errorf("expected title within limit, got %d runes", n)
+		}
+	})
+}
+
+func TestFitEmbed(t *testing.T) {
+	base := DiscordEmbed{
+		Title:       strings.Repeat("T", 256),
+		Description: strings.Repeat("a", 4096),
+		Author:      &DiscordEmbedAuthor{Name: strings.Repeat("A", 256)},
+		Footer:      &DiscordEmbedFooter{Text: "ICLR 2025 · ID: PID"},
+	}
+
+	t.Run("description is shrunk before fields are dropped", func(t *testing.T) {
+		e := base
+		e.Fields = []DiscordEmbedField{
+			{Name: "TL;DR", Value: strings.Repeat("t", 1024)},
+			{Name: "Decision", Value: strings.Repeat("d", 1024)},
+			{Name: "PDF", Value: "https://example.com/a.pdf"},
+		}
+		got := fitEmbed(e)
+		if n := embedLength(got); n > discordEmbedTotalMaxChars {
+			t.Fatalf("embed has %d chars, exceeds %d", n, discordEmbedTotalMaxChars)
+		}
+		if len(got.Fields) != 3 {
+			t.Errorf("expected all fields to be kept, got %+v", got.Fields)
+		}
+		if !strings.HasSuffix(got.Description, "...") {
+			t.Errorf("expected description to be truncated")
+		}
+	})
+
+	t.Run("longest fields are dropped once description reaches the floor", func(t *testing.T) {
+		e := base
+		e.Fields = []DiscordEmbedField{
+			{Name: "TL;DR", Value: strings.Repeat("t", 1024)},
+			{Name: "Keywords", Value: strings.Repeat("k", 300)},
+			{Name: "Note", Value: strings.Repeat("n", 1024)},
+			{Name: "Decision", Value: strings.Repeat("d", 1024)},
+			{Name: "PDF", Value: "https://example.com/a.pdf"},
+		}
+		e.Footer = &DiscordEmbedFooter{Text: strings.Repeat("f", 2048)}
+		got := fitEmbed(e)
+		if n := embedLength(got); n > discordEmbedTotalMaxChars {
+			t.Fatalf("embed has %d chars, exceeds %d", n, discordEmbedTotalMaxChars)
+		}
+		if runeLen(got.Description) != minShrunkAbstractChars {
+			t.Errorf("expected description at the floor (%d), got %d", minShrunkAbstractChars, runeLen(got.Description))
+		}
+		var names []string
+		for _, f := range got.Fields {
+			names = append(names, f.Name)
+		}
+		if !slices.Contains(names, "PDF") || slices.Contains(names, "Decision") {
+			t.Errorf("expected the longest fields to be dropped first, got %v", names)
+		}
+	})
+
+	t.Run("embed within limit is untouched", func(t *testing.T) {
+		e := DiscordEmbed{Title: "t", Description: "d", Fields: []DiscordEmbedField{{Name: "PDF", Value: "x"}}}
+		if got := fitEmbed(e); got.Description != "d" || len(got.Fields) != 1 {
+			t.Errorf("expected embed to be unchanged, got %+v", got)
+		}
+	})
+}
@@ -5,68 +5,147 @@ import (
 	"strings"
 
 	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
-	"github.com/hayashi-yaken/daily-paper-bot/internal/openreview"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+	"github.com/slack-go/slack"
 )
 
 // Message は投稿メッセージのペアを表します。
-// Main は親メッセージ（または単発メッセージ）、Sub は Slack スレッド子用の補助メッセージ。
-// Discord は Sub を無視します。
+// Main は親メッセージ（または単発メッセージ）、Sub はスレッド（Discord では後続メッセージまたはスレッド）用の補助メッセージ。
+// Blocks は Slack の Block Kit レイアウトです。設定されている場合、Main は通知用のフォールバックテキストになります。
+// Embeds は Discord の embed です。設定されている場合、Discord には Main の代わりに embed を投稿します。
+// Title はスレッド名などに使う論文タイトルです。
 type Message struct {
-	Main string
-	Sub  string
+	Main   string
+	Sub    string
+	Title  string
+	Blocks []slack.Block
+	Embeds []DiscordEmbed
 }
 
 // Formatter は論文情報をプラットフォーム別のメッセージに整形するインターフェースです。
 type Formatter interface {
-	Format(paper *openreview.Note, venue config.VenueConfig, abstractMaxChars int, jaAbstract string) Message
+	Format(paper *paper.Paper, venue config.VenueConfig, abstractMaxChars int, jaAbstract string) Message
 }
 
 // --- Discord Formatter (Standard Markdown) ---
 
-type discordFormatter struct{}
+type discordFormatter struct {
+	tmpl *messageTemplate
+}
 
-// NewDiscordFormatter は Discord 用の Formatter を返します。
+// NewDiscordFormatter はデフォルトテンプレートを使う Discord 用の Formatter を返します。
 func NewDiscordFormatter() Formatter {
-	return &discordFormatter{}
+	f, _ := NewDiscordFormatterFromTemplate("")
+	return f
+}
+
+// NewDiscordFormatterFromTemplate は path のテンプレートでデフォルトテンプレートを上書きした Discord 用の Formatter を返します。
+// path が空の場合はデフォルトテンプレートを使います。
+func NewDiscordFormatterFromTemplate(path string) (Formatter, error) {
+	tmpl, err := newMessageTemplate("discord", path)
+	if err != nil {
+		return nil, err
+	}
+	return &discordFormatter{tmpl: tmpl}, nil
 }
 
-func (f *discordFormatter) Format(paper *openreview.Note, venue config.VenueConfig, abstractMaxChars int, jaAbstract string) Message {
-	paperLink := fmt.Sprintf("https://openreview.net/forum?id=%s", paper.ID)
-	headerText := fmt.Sprintf("📄 今日の論文 (%s %d)", venue.Name, venue.Year)
-	header := fmt.Sprintf("[%s](%s)", headerText, paperLink)
+func (f *discordFormatter) Format(paper *paper.Paper, venue config.VenueConfig, abstractMaxChars int, jaAbstract string) Message {
+	data := newTemplateData(paper, venue, jaAbstract)
 
-	abs := abstractBlock(paper.Content.Abstract.Value, jaAbstract, abstractMaxChars)
-	if jaAbstract != "" {
-		abs += fmt.Sprintf("\n\n*Original Abstract*:\n||%s||", truncateRunes(paper.Content.Abstract.Value, abstractMaxChars))
+	return Message{
+		Main:   fitTemplate(f.tmpl, templateMain, data, abstractMaxChars, discordContentMaxChars),
+		Sub:    fitTemplate(f.tmpl, templateSub, data, abstractMaxChars, discordContentMaxChars),
+		Title:  paper.Title,
+		Embeds: []DiscordEmbed{discordEmbed(f.tmpl, data, abstractMaxChars)},
 	}
-	return Message{Main: formatMessage(paper, header, abs)}
 }
 
 // --- Slack Formatter (Slack Mrkdwn) ---
 
-type slackFormatter struct{}
+type slackFormatter struct {
+	tmpl *messageTemplate
+}
 
-// NewSlackFormatter は Slack 用の Formatter を返します。
+// NewSlackFormatter はデフォルトテンプレートを使う Slack 用の Formatter を返します。
 func NewSlackFormatter() Formatter {
-	return &slackFormatter{}
+	f, _ := NewSlackFormatterFromTemplate("")
+	return f
 }
 
-func (f *slackFormatter) Format(paper *openreview.Note, venue config.VenueConfig, abstractMaxChars int, jaAbstract string) Message {
-	paperLink := fmt.Sprintf("https://openreview.net/forum?id=%s", paper.ID)
-	headerText := fmt.Sprintf("📄 今日の論文 (%s %d)", venue.Name, venue.Year)
-	header := fmt.Sprintf("<%s|%s>", paperLink, headerText)
+// NewSlackFormatterFromTemplate は path のテンプレートでデフォルトテンプレートを上書きした Slack 用の Formatter を返します。
+// path が空の場合はデフォルトテンプレートを使います。
+func NewSlackFormatterFromTemplate(path string) (Formatter, error) {
+	tmpl, err := newMessageTemplate("slack", path)
+	if err != nil {
+		return nil, err
+	}
+	return &slackFormatter{tmpl: tmpl}, nil
+}
 
-	main := formatMessage(paper, header, abstractBlock(paper.Content.Abstract.Value, jaAbstract, abstractMaxChars))
+func (f *slackFormatter) Format(paper *paper.Paper, venue config.VenueConfig, abstractMaxChars int, jaAbstract string) Message {
+	data := newTemplateData(paper, venue, jaAbstract)
 
-	var sub string
-	if jaAbstract != "" {
-		sub = fmt.Sprintf("*Original Abstract*:\n%s", truncateRunes(paper.Content.Abstract.Value, abstractMaxChars))
+	return Message{
+		Main:   fitTemplate(f.tmpl, templateMain, data, abstractMaxChars, slackTextMaxChars),
+		Sub:    fitTemplate(f.tmpl, templateSub, data, abstractMaxChars, slackTextMaxChars),
+		Title:  paper.Title,
+		Blocks: slackBlocks(f.tmpl, data, abstractMaxChars),
 	}
-	return Message{Main: main, Sub: sub}
+}
+
+// --- Text Only ---
+
+type textOnlyFormatter struct {
+	Formatter
+}
+
+// TextOnly は f の Block Kit と embed を使わず、テンプレートで出力した Main をそのまま本文として投稿させる Formatter を返します。
+// テンプレートで変えた項目名や並び順を、実際の投稿にも反映したい場合に使います。
+func TextOnly(f Formatter) Formatter {
+	return textOnlyFormatter{Formatter: f}
+}
+
+func (f textOnlyFormatter) Format(paper *paper.Paper, venue config.VenueConfig, abstractMaxChars int, jaAbstract string) Message {
+	msg := f.Formatter.Format(paper, venue, abstractMaxChars, jaAbstract)
+	msg.Blocks = nil
+	msg.Embeds = nil
+	return msg
 }
 
 // --- Helper Function ---
 
+// sourceLabel は論文ページへのリンクに表示する取得元の名前を返します。
+func sourceLabel(paper *paper.Paper) string {
+	switch paper.Source {
+	case config.SourceOpenReview:
+		return "OpenReview"
+	case config.SourceArXiv:
+		return "arXiv"
+	default:
+		return "Paper"
+	}
+}
+
+// venueLabel は学会名と年を "ICLR 2025" の形式で返します。年が未設定 (arXiv や論文リストなど) の場合は学会名のみを返します。
+func venueLabel(venue config.VenueConfig) string {
+	if venue.Year == 0 {
+		return venue.Name
+	}
+	return fmt.Sprintf("%s %d", venue.Name, venue.Year)
+}
+
+// ratingSummary は平均評価スコアを "7.3 (4 reviews, confidence 3.8)" の形式で返します。スコアがない場合は空文字を返します。
+func ratingSummary(r *paper.Reviews) string {
+	if r == nil || len(r.Ratings) == 0 {
+		return ""
+	}
+	summary := fmt.Sprintf("%.1f (%d reviews", r.MeanRating(), len(r.Ratings))
+	if len(r.Confidences) > 0 {
+		summary += fmt.Sprintf(", confidence %.1f", r.MeanConfidence())
+	}
+	return summary + ")"
+}
+
 func truncateRunes(s string, max int) string {
 	if max <= 0 || len([]rune(s)) <= max {
 		return s
@@ -74,32 +153,24 @@ func truncateRunes(s string, max int) string {
 	return string([]rune(s)[:max]) + "..."
 }
 
-func abstractBlock(originalAbstract, jaAbstract string, abstractMaxChars int) string {
-	if jaAbstract != "" {
-		return fmt.Sprintf("*Abstract (日本語)*:\n%s", truncateRunes(jaAbstract, abstractMaxChars))
-	}
-	return fmt.Sprintf("*Abstract*:\n%s", truncateRunes(originalAbstract, abstractMaxChars))
+// withAbstractMax は Abstract と翻訳済み Abstract を abstractMaxChars ルーンに切り詰めた TemplateData を返します。
+func withAbstractMax(data TemplateData, abstractMaxChars int) TemplateData {
+	data.Abstract = truncateRunes(data.Abstract, abstractMaxChars)
+	data.JaAbstract = truncateRunes(data.JaAbstract, abstractMaxChars)
+	return data
 }
 
-func formatMessage(paper *openreview.Note, header, abstractBlock string) string {
-	authors := strings.Join(paper.Content.Authors.Value, ", ")
-
-	var pdfLine string
-	if pdfPath := paper.Content.PDF.Value; pdfPath != "" {
-		pdfURL := pdfPath
-		if !strings.HasPrefix(pdfPath, "http") {
-			pdfURL = "https://openreview.net" + pdfPath
-		}
-		pdfLine = fmt.Sprintf("\n\n*PDF*: %s", pdfURL)
+// fitTemplate は名前付きテンプレートを実行し、limit ルーン以内に収まるよう Abstract → 著者リストの順に縮めます。
+// 出力が空白のみの場合は空文字を返します。
+func fitTemplate(tmpl *messageTemplate, name string, data TemplateData, abstractMaxChars int, limit int) string {
+	abstractLen := max(runeLen(data.Abstract), runeLen(data.JaAbstract))
+	text := fitText(limit, abstractMaxChars, abstractLen, data.Paper.Authors, func(absMax int, authors string) string {
+		d := withAbstractMax(data, absMax)
+		d.Authors = authors
+		return tmpl.render(name, d)
+	})
+	if strings.TrimSpace(text) == "" {
+		return ""
 	}
-
-	return fmt.Sprintf(
-		"%s\n\n*Title*: %s\n*Authors*: %s\n\n%s%s\n\nID: `%s`",
-		header,
-		paper.Content.Title.Value,
-		authors,
-		abstractBlock,
-		pdfLine,
-		paper.ID,
-	)
+	return text
 }
@@ -5,16 +5,16 @@ import (
 	"testing"
 
 	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
-	"github.com/hayashi-yaken/daily-paper-bot/internal/openreview"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+	"github.com/slack-go/slack"
 )
 
 func TestFormatters_HeaderLinkPointsToPaper(t *testing.T) {
-	paper := &openreview.Note{
-		ID: "ABC123",
-		Content: openreview.NoteContent{
-			Title:   openreview.ValueField[string]{Value: "T"},
-			Authors: openreview.ValueField[[]string]{Value: []string{"A"}},
-		},
+	paper := &paper.Paper{
+		ID:      "ABC123",
+		URL:     "https://openreview.net/forum?id=ABC123",
+		Title:   "T",
+		Authors: []string{"A"},
 	}
 	venue := config.VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025}
 
@@ -35,17 +35,83 @@ func TestFormatters_HeaderLinkPointsToPaper(t *testing.T) {
 	})
 }
 
+func TestFormatters_ArXivPaperLinksToAbsPage(t *testing.T) {
+	paper := &paper.Paper{
+		ID:      "2401.01234",
+		Source:  config.SourceArXiv,
+		URL:     "http://arxiv.org/abs/2401.01234v2",
+		Title:   "T",
+		Authors: []string{"A"},
+		PDFURL:  "http://arxiv.org/pdf/2401.01234v2",
+	}
+	venue := config.VenueConfig{Name: "arXiv cs.CL", Venue: "cat:cs.CL", Type: config.SourceArXiv}
+
+	t.Run("Discord header links to abs page", func(t *testing.T) {
+		msg := NewDiscordFormatter().Format(paper, venue, 100, "")
+		if !strings.Contains(msg.Main, "(http://arxiv.org/abs/2401.01234v2)") {
+			t.Errorf("Discord header link wrong.\nGot: %s", msg.Main)
+		}
+		if strings.Contains(msg.Main, "openreview.net") {
+			t.Errorf("arXiv paper should not link to OpenReview.\nGot: %s", msg.Main)
+		}
+	})
+
+	t.Run("Slack button is labelled arXiv", func(t *testing.T) {
+		msg := NewSlackFormatter().Format(paper, venue, 100, "")
+		actions := msg.Blocks[len(msg.Blocks)-1].(*slack.ActionBlock)
+		button := actions.Elements.ElementSet[0].(*slack.ButtonBlockElement)
+		if button.Text.Text != "arXiv" || button.URL != "http://arxiv.org/abs/2401.01234v2" {
+			t.Errorf("unexpected button: %q -> %q", button.Text.Text, button.URL)
+		}
+	})
+}
+
+func TestFormatters_ReadingListPaperWithoutLink(t *testing.T) {
+	paper := &paper.Paper{
+		ID:      "vaswani2017attention",
+		Source:  config.SourceBibTeX,
+		Title:   "Attention Is All You Need",
+		Authors: []string{"Ashish Vaswani"},
+	}
+	venue := config.VenueConfig{Name: "Reading group", Venue: "data/reading.bib", Type: config.SourceBibTeX}
+
+	t.Run("Discord header is plain text without year", func(t *testing.T) {
+		msg := NewDiscordFormatter().Format(paper, venue, 100, "")
+		if !strings.HasPrefix(msg.Main, "📄 今日の論文 (Reading group)\n") {
+			t.Errorf("unexpected header.\nGot: %s", msg.Main)
+		}
+		if msg.Embeds[0].URL != "" {
+			t.Errorf("expected no embed url, got %q", msg.Embeds[0].URL)
+		}
+	})
+
+	t.Run("Slack omits links and buttons", func(t *testing.T) {
+		msg := NewSlackFormatter().Format(paper, venue, 100, "")
+		if !strings.HasPrefix(msg.Main, "📄 今日の論文 (Reading group)\n") {
+			t.Errorf("unexpected header.\nGot: %s", msg.Main)
+		}
+		for _, b := range msg.Blocks {
+			if b.BlockType() == slack.MBTAction {
+				t.Error("expected no actions block without links")
+			}
+		}
+		info := msg.Blocks[1].(*slack.SectionBlock)
+		if info.Fields[0].Text != "*Title*\nAttention Is All You Need" {
+			t.Errorf("unexpected title field: %q", info.Fields[0].Text)
+		}
+	})
+}
+
 func TestFormatters_PDFLine(t *testing.T) {
 	venue := config.VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025}
 
 	t.Run("Slack with PDF shows *PDF* line", func(t *testing.T) {
-		paper := &openreview.Note{
-			ID: "PID",
-			Content: openreview.NoteContent{
-				Title:   openreview.ValueField[string]{Value: "T"},
-				Authors: openreview.ValueField[[]string]{Value: []string{"A"}},
-				PDF:     openreview.ValueField[string]{Value: "/pdf?id=PID"},
-			},
+		paper := &paper.Paper{
+			ID:      "PID",
+			URL:     "https://openreview.net/forum?id=PID",
+			Title:   "T",
+			Authors: []string{"A"},
+			PDFURL:  "https://openreview.net/pdf?id=PID",
 		}
 		msg := NewSlackFormatter().Format(paper, venue, 100, "")
 		if !strings.Contains(msg.Main, "*PDF*: https://openreview.net/pdf?id=PID") {
@@ -57,12 +123,11 @@ func TestFormatters_PDFLine(t *testing.T) {
 	})
 
 	t.Run("Slack without PDF omits link line", func(t *testing.T) {
-		paper := &openreview.Note{
-			ID: "PID",
-			Content: openreview.NoteContent{
-				Title:   openreview.ValueField[string]{Value: "T"},
-				Authors: openreview.ValueField[[]string]{Value: []string{"A"}},
-			},
+		paper := &paper.Paper{
+			ID:      "PID",
+			URL:     "https://openreview.net/forum?id=PID",
+			Title:   "T",
+			Authors: []string{"A"},
 		}
 		msg := NewSlackFormatter().Format(paper, venue, 100, "")
 		if strings.Contains(msg.Main, "*PDF*:") {
@@ -74,13 +139,12 @@ func TestFormatters_PDFLine(t *testing.T) {
 	})
 
 	t.Run("Discord with PDF shows *PDF* line", func(t *testing.T) {
-		paper := &openreview.Note{
-			ID: "PID",
-			Content: openreview.NoteContent{
-				Title:   openreview.ValueField[string]{Value: "T"},
-				Authors: openreview.ValueField[[]string]{Value: []string{"A"}},
-				PDF:     openreview.ValueField[string]{Value: "/pdf?id=PID"},
-			},
+		paper := &paper.Paper{
+			ID:      "PID",
+			URL:     "https://openreview.net/forum?id=PID",
+			Title:   "T",
+			Authors: []string{"A"},
+			PDFURL:  "https://openreview.net/pdf?id=PID",
 		}
 		msg := NewDiscordFormatter().Format(paper, venue, 100, "")
 		if !strings.Contains(msg.Main, "*PDF*: https://openreview.net/pdf?id=PID") {
@@ -90,13 +154,12 @@ func TestFormatters_PDFLine(t *testing.T) {
 }
 
 func TestSlackFormatter_WithTranslation(t *testing.T) {
-	paper := &openreview.Note{
-		ID: "PID",
-		Content: openreview.NoteContent{
-			Title:    openreview.ValueField[string]{Value: "T"},
-			Authors:  openreview.ValueField[[]string]{Value: []string{"A"}},
-			Abstract: openreview.ValueField[string]{Value: "english abstract"},
-		},
+	paper := &paper.Paper{
+		ID:       "PID",
+		URL:      "https://openreview.net/forum?id=PID",
+		Title:    "T",
+		Authors:  []string{"A"},
+		Abstract: "english abstract",
 	}
 	venue := config.VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025}
 
@@ -114,13 +177,12 @@ func TestSlackFormatter_WithTranslation(t *testing.T) {
 }
 
 func TestDiscordFormatter_WithTranslation(t *testing.T) {
-	paper := &openreview.Note{
-		ID: "PID",
-		Content: openreview.NoteContent{
-			Title:    openreview.ValueField[string]{Value: "T"},
-			Authors:  openreview.ValueField[[]string]{Value: []string{"A"}},
-			Abstract: openreview.ValueField[string]{Value: "english abstract"},
-		},
+	paper := &paper.Paper{
+		ID:       "PID",
+		URL:      "https://openreview.net/forum?id=PID",
+		Title:    "T",
+		Authors:  []string{"A"},
+		Abstract: "english abstract",
 	}
 	venue := config.VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025}
 
@@ -129,22 +191,21 @@ func TestDiscordFormatter_WithTranslation(t *testing.T) {
 	if !strings.Contains(msg.Main, "*Abstract (日本語)*:\n日本語訳テスト") {
 		t.Errorf("expected Main to contain Japanese abstract heading.\nGot: %s", msg.Main)
 	}
-	if !strings.Contains(msg.Main, "*Original Abstract*:\n||english abstract||") {
-		t.Errorf("expected Main to contain spoilered original abstract.\nGot: %s", msg.Main)
+	if strings.Contains(msg.Main, "english abstract") {
+		t.Errorf("expected Main not to contain original abstract when translated.\nGot: %s", msg.Main)
 	}
-	if msg.Sub != "" {
-		t.Errorf("Discord Sub must remain empty, got %q", msg.Sub)
+	if !strings.Contains(msg.Sub, "*Original Abstract*:\nenglish abstract") {
+		t.Errorf("expected Sub to contain original abstract block.\nGot: %s", msg.Sub)
 	}
 }
 
 func TestSlackFormatter_WithoutTranslation_LegacyHeading(t *testing.T) {
-	paper := &openreview.Note{
-		ID: "PID",
-		Content: openreview.NoteContent{
-			Title:    openreview.ValueField[string]{Value: "T"},
-			Authors:  openreview.ValueField[[]string]{Value: []string{"A"}},
-			Abstract: openreview.ValueField[string]{Value: "english abstract"},
-		},
+	paper := &paper.Paper{
+		ID:       "PID",
+		URL:      "https://openreview.net/forum?id=PID",
+		Title:    "T",
+		Authors:  []string{"A"},
+		Abstract: "english abstract",
 	}
 	venue := config.VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025}
 
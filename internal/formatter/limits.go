@@ -0,0 +1,112 @@
+package formatter
+
+import (
+	"sort"
+	"strings"
+)
+
+// プラットフォームごとのメッセージ本文の文字数上限です。
+const (
+	discordContentMaxChars = 2000 // これを超えると Webhook が 400 を返す
+	slackTextMaxChars      = 4000 // これを超えると Slack 側で切り詰められる
+)
+
+// minShrunkAbstractChars は文字数上限に収めるために Abstract を縮める際の下限です。
+// これ以上縮める必要がある場合は、次に著者リストを縮めます。
+const minShrunkAbstractChars = 200
+
+// etAl は省略した著者リストの末尾に付ける文字列です。
+const etAl = " et al."
+
+// runeLen は文字列のルーン数を返します。
+func runeLen(s string) int {
+	return len([]rune(s))
+}
+
+// truncateWithin は末尾の "..." を含めて limit ルーン以内に収まるように文字列を切り詰めます。
+func truncateWithin(s string, limit int) string {
+	if limit <= 0 || runeLen(s) <= limit {
+		return s
+	}
+	if limit <= 3 {
+		return string([]rune(s)[:limit])
+	}
+	return truncateRunes(s, limit-3)
+}
+
+// joinAuthors は先頭 n 人の著者をカンマ区切りで連結し、省略した著者がいれば " et al." を付けます。
+func joinAuthors(authors []string, n int) string {
+	if n >= len(authors) {
+		return strings.Join(authors, ", ")
+	}
+	return strings.Join(authors[:n], ", ") + etAl
+}
+
+// joinAuthorsWithin は limit ルーン以内に収まるだけの著者を連結し、残りを " et al." で省略します。
+// 先頭の著者1人だけでも収まらない場合はその名前を切り詰めます。
+func joinAuthorsWithin(authors []string, limit int) string {
+	if s := joinAuthors(authors, len(authors)); runeLen(s) <= limit {
+		return s
+	}
+	if n, ok := maxAuthorsWithin(authors, func(s string) bool { return runeLen(s) <= limit }); ok {
+		return joinAuthors(authors, n)
+	}
+	return truncateWithin(joinAuthors(authors, 1), limit)
+}
+
+// maxAuthorsWithin は著者を省略した表記 (1 <= n < len(authors)) のうち、fits を満たす最大の n を二分探索で求めます。
+// 著者数が少ないほど表記は短くなるため、fits は n について単調であることを前提とします。
+func maxAuthorsWithin(authors []string, fits func(authorsText string) bool) (int, bool) {
+	if len(authors) <= 1 {
+		return 0, false
+	}
+	// i 番目は n = len(authors)-1-i 人に対応する (i が増えるほど短くなる)
+	i := sort.Search(len(authors)-1, func(i int) bool {
+		return fits(joinAuthors(authors, len(authors)-1-i))
+	})
+	if i == len(authors)-1 {
+		return 0, false
+	}
+	return len(authors) - 1 - i, true
+}
+
+// fitText は build が返す文字列が limit ルーン以内に収まるまで、
+// Abstract (abstractMax を minShrunkAbstractChars まで縮小) → 著者リスト ("et al." で省略) の順に縮めます。
+// それでも収まらない場合は末尾を切り詰めます。同じ入力に対しては常に同じ結果を返します。
+//
+// abstractLen は build 内で切り詰め対象となる Abstract の最大ルーン数です。
+func fitText(limit, abstractMax, abstractLen int, authors []string, build func(abstractMax int, authors string) string) string {
+	if abstractMax <= 0 || abstractMax > abstractLen {
+		abstractMax = abstractLen
+	}
+	authorsText := joinAuthors(authors, len(authors))
+
+	text := build(abstractMax, authorsText)
+	if runeLen(text) <= limit {
+		return text
+	}
+
+	// 1. Abstract を縮める (切り詰め時に付く "..." の分も差し引く)
+	for abstractMax > minShrunkAbstractChars {
+		over := runeLen(text) - limit
+		if over <= 0 {
+			return text
+		}
+		abstractMax = max(abstractMax-over-3, minShrunkAbstractChars)
+		text = build(abstractMax, authorsText)
+	}
+	if runeLen(text) <= limit {
+		return text
+	}
+
+	// 2. 著者リストを "et al." で省略する
+	if n, ok := maxAuthorsWithin(authors, func(a string) bool { return runeLen(build(abstractMax, a)) <= limit }); ok {
+		return build(abstractMax, joinAuthors(authors, n))
+	}
+	if len(authors) > 1 {
+		text = build(abstractMax, joinAuthors(authors, 1))
+	}
+
+	// 3. 最終手段として末尾を切り詰める
+	return truncateWithin(text, limit)
+}
@@ -0,0 +1,176 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+)
+
+// pathologicalPaper は著者数・Abstract・タイトルが極端に長い論文を返します。
+func pathologicalPaper(numAuthors, abstractLen, titleLen int) *paper.Paper {
+	authors := make([]string, numAuthors)
+	for i := range authors {
+		authors[i] = fmt.Sprintf("Author Number%03d", i)
+	}
+	return &paper.Paper{
+		ID:       "PID",
+		URL:      "https://openreview.net/forum?id=PID",
+		Title:    strings.Repeat("T", titleLen),
+		Authors:  authors,
+		Abstract: strings.Repeat("a", abstractLen),
+		PDFURL:   "https://openreview.net/pdf?id=PID",
+	}
+}
+
+// withLongOptionalFields は TL;DR・キーワード・採否・評価を極端に長くした論文を返します。
+func withLongOptionalFields(p *paper.Paper) *paper.Paper {
+	p.TLDR = strings.Repeat("t", 5000)
+	p.Keywords = make([]string, 500)
+	for i := range p.Keywords {
+		p.Keywords[i] = fmt.Sprintf("keyword%03d", i)
+	}
+	p.Reviews = &paper.Reviews{Decision: strings.Repeat("d", 5000), NumReviews: 3, Ratings: []float64{8, 6, 6}}
+	return p
+}
+
+func TestTruncateWithin(t *testing.T) {
+	testCases := []struct {
+		name  string
+		in    string
+		limit int
+		want  string
+	}{
+		{name: "within limit", in: "abc", limit: 3, want: "abc"},
+		{name: "ellipsis counted", in: "abcdef", limit: 5, want: "ab..."},
+		{name: "multibyte", in: "あいうえおか", limit: 5, want: "あい..."},
+		{name: "tiny limit", in: "abcdef", limit: 2, want: "ab"},
+		{name: "no limit", in: "abcdef", limit: 0, want: "abcdef"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := truncateWithin(tc.in, tc.limit); got != tc.want {
+				t.Errorf("truncateWithin(%q, %d) = %q, want %q", tc.in, tc.limit, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestJoinAuthorsWithin(t *testing.T) {
+	authors := []string{"Alice", "Bob", "Carol"}
+
+	t.Run("all authors fit", func(t *testing.T) {
+		if got := joinAuthorsWithin(authors, 100); got != "Alice, Bob, Carol" {
+			t.Errorf("unexpected authors: %q", got)
+		}
+	})
+
+	t.Run("omits trailing authors with et al.", func(t *testing.T) {
+		if got := joinAuthorsWithin(authors, 16); got != "Alice et al." {
+			t.Errorf("unexpected authors: %q", got)
+		}
+	})
+
+	t.Run("first author alone too long", func(t *testing.T) {
+		got := joinAuthorsWithin([]string{strings.Repeat("x", 50), "Bob"}, 10)
+		if utf8.RuneCountInString(got) > 10 {
+			t.Errorf("result exceeds limit: %q", got)
+		}
+	})
+}
+
+func TestFormatters_PlatformLimits(t *testing.T) {
+	venue := config.VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025}
+
+	testCases := []struct {
+		name       string
+		paper      *paper.Paper
+		jaAbstract string
+	}{
+		{name: "hundreds of authors", paper: pathologicalPaper(500, 300, 80)},
+		{name: "very long abstract", paper: pathologicalPaper(3, 10000, 80)},
+		{name: "very long translated abstract", paper: pathologicalPaper(3, 10000, 80), jaAbstract: strings.Repeat("あ", 10000)},
+		{name: "very long title", paper: pathologicalPaper(3, 300, 5000)},
+		{name: "everything at once", paper: pathologicalPaper(1000, 10000, 5000), jaAbstract: strings.Repeat("あ", 10000)},
+		{name: "every embed field at its limit", paper: withLongOptionalFields(pathologicalPaper(1000, 10000, 5000)), jaAbstract: strings.Repeat("あ", 10000)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// ABSTRACT_MAX_CHARS を無制限 (0) にしても上限を超えないこと
+			discord := NewDiscordFormatter().Format(tc.paper, venue, 0, tc.jaAbstract)
+			assertWithin(t, "discord Main", discord.Main, discordContentMaxChars)
+			assertWithin(t, "discord Sub", discord.Sub, discordContentMaxChars)
+			e := discord.Embeds[0]
+			assertWithin(t, "embed title", e.Title, discordEmbedTitleMaxChars)
+			assertWithin(t, "embed description", e.Description, discordEmbedDescriptionMaxChars)
+			if e.Author != nil {
+				assertWithin(t, "embed author", e.Author.Name, discordEmbedAuthorMaxChars)
+			}
+			for _, f := range e.Fields {
+				assertWithin(t, "embed field "+f.Name, f.Value, discordEmbedFieldValueMaxChars)
+			}
+			if n := embedLength(e); n > discordEmbedTotalMaxChars {
+				t.Errorf("embed has %d chars in total, exceeds limit %d", n, discordEmbedTotalMaxChars)
+			}
+
+			slackMsg := NewSlackFormatter().Format(tc.paper, venue, 0, tc.jaAbstract)
+			assertWithin(t, "slack Main", slackMsg.Main, slackTextMaxChars)
+			assertWithin(t, "slack Sub", slackMsg.Sub, slackTextMaxChars)
+		})
+	}
+}
+
+func TestFitMain_ShrinksAbstractBeforeAuthors(t *testing.T) {
+	venue := config.VenueConfig{Name: "ICLR", Year: 2025}
+
+	t.Run("long abstract with few authors keeps all authors", func(t *testing.T) {
+		paper := pathologicalPaper(3, 10000, 80)
+		msg := NewDiscordFormatter().Format(paper, venue, 0, "")
+		if !strings.Contains(msg.Main, "Author Number000, Author Number001, Author Number002") {
+			t.Errorf("expected all authors to be kept:\n%s", msg.Main)
+		}
+		if strings.Contains(msg.Main, etAl) {
+			t.Errorf("did not expect et al.:\n%s", msg.Main)
+		}
+	})
+
+	t.Run("many authors are abbreviated after abstract reaches the floor", func(t *testing.T) {
+		paper := pathologicalPaper(500, 1000, 80)
+		msg := NewDiscordFormatter().Format(paper, venue, 0, "")
+		if !strings.Contains(msg.Main, etAl) {
+			t.Errorf("expected et al. in authors:\n%s", msg.Main)
+		}
+		if !strings.Contains(msg.Main, strings.Repeat("a", minShrunkAbstractChars)+"...") {
+			t.Errorf("expected abstract to be shrunk to %d chars:\n%s", minShrunkAbstractChars, msg.Main)
+		}
+	})
+
+	t.Run("short message is left untouched", func(t *testing.T) {
+		paper := pathologicalPaper(2, 100, 10)
+		msg := NewDiscordFormatter().Format(paper, venue, 0, "")
+		if strings.Contains(msg.Main, "...") || strings.Contains(msg.Main, etAl) {
+			t.Errorf("did not expect any shrinking:\n%s", msg.Main)
+		}
+	})
+
+	t.Run("deterministic", func(t *testing.T) {
+		paper := pathologicalPaper(1000, 10000, 5000)
+		first := NewSlackFormatter().Format(paper, venue, 0, "")
+		for i := 0; i < 3; i++ {
+			if got := NewSlackFormatter().Format(paper, venue, 0, ""); got.Main != first.Main {
+				t.Fatalf("expected identical output on repeated formatting")
+			}
+		}
+	})
+}
+
+func assertWithin(t *testing.T, label, s string, limit int) {
+	t.Helper()
+	if n := utf8.RuneCountInString(s); n > limit {
+		t.Errorf("%s has %d chars, exceeds limit %d", label, n, limit)
+	}
+}
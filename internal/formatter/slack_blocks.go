@@ -0,0 +1,101 @@
+package formatter
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/slack-go/slack"
+)
+
+// Block Kit の各要素の文字数上限です。
+const (
+	slackHeaderMaxChars  = 150
+	slackSectionMaxChars = 3000
+	slackFieldMaxChars   = 2000
+)
+
+// slackBlocks は論文情報を Block Kit レイアウトに変換します。
+// header (見出し) / section (タイトル・著者・採否・評価) / section (TL;DR) / section (Abstract) / context (学会・主分野・キーワード・ID) / actions (論文ページ・PDF ボタン。リンクがなければ省略) の順に並びます。
+// 見出しと Abstract 欄はテンプレートの "header" / "abstract" で出力します。
+func slackBlocks(tmpl *messageTemplate, data TemplateData, abstractMaxChars int) []slack.Block {
+	paper, venue := data.Paper, data.Venue
+	headerText := tmpl.render(templateHeader, data)
+	header := slack.NewHeaderBlock(
+		slack.NewTextBlockObject(slack.PlainTextType, truncateWithin(headerText, slackHeaderMaxChars), true, false),
+	)
+
+	titleText := escapeSlack(truncateWithin(paper.Title, slackFieldMaxChars/2))
+	if paper.URL != "" {
+		titleText = fmt.Sprintf("<%s|%s>", paper.URL, titleText)
+	}
+	title := fmt.Sprintf("*Title*\n%s", titleText)
+	authors := fmt.Sprintf("*Authors*\n%s", escapeSlack(joinAuthorsWithin(paper.Authors, slackFieldMaxChars/2)))
+	fields := []*slack.TextBlockObject{
+		slack.NewTextBlockObject(slack.MarkdownType, title, false, false),
+		slack.NewTextBlockObject(slack.MarkdownType, authors, false, false),
+	}
+	if r := data.Reviews; r != nil {
+		if r.Decision != "" {
+			fields = append(fields, slack.NewTextBlockObject(slack.MarkdownType, "*Decision*\n"+escapeSlack(truncateWithin(r.Decision, slackFieldMaxChars/2)), false, false))
+		}
+		if rating := ratingSummary(r); rating != "" {
+			fields = append(fields, slack.NewTextBlockObject(slack.MarkdownType, "*Rating*\n"+rating, false, false))
+		}
+	}
+	info := slack.NewSectionBlock(nil, fields, nil)
+
+	blocks := []slack.Block{header, info}
+
+	if data.TLDR != "" {
+		blocks = append(blocks, slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, truncateWithin("*TL;DR*\n"+escapeSlack(data.TLDR), slackSectionMaxChars), false, false),
+			nil, nil,
+		))
+	}
+
+	if data.Abstract != "" || data.JaAbstract != "" {
+		escaped := withAbstractMax(data, abstractMaxChars)
+		escaped.Abstract = escapeSlack(escaped.Abstract)
+		escaped.JaAbstract = escapeSlack(escaped.JaAbstract)
+		abs := tmpl.render(templateAbstract, escaped)
+		blocks = append(blocks, slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, truncateWithin(abs, slackSectionMaxChars), false, false),
+			nil, nil,
+		))
+	}
+
+	contextElements := []slack.MixedElement{
+		slack.NewTextBlockObject(slack.MarkdownType, escapeSlack(venueLabel(venue)), false, false),
+	}
+	if data.PrimaryArea != "" {
+		contextElements = append(contextElements, slack.NewTextBlockObject(slack.MarkdownType, escapeSlack(truncateWithin(data.PrimaryArea, slackFieldMaxChars)), false, false))
+	}
+	if data.Keywords != "" {
+		contextElements = append(contextElements, slack.NewTextBlockObject(slack.MarkdownType, "🏷 "+escapeSlack(data.Keywords), false, false))
+	}
+	contextElements = append(contextElements, slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("ID: `%s`", paper.ID), false, false))
+	blocks = append(blocks, slack.NewContextBlock("", contextElements...))
+
+	// リンクを開くだけのボタンのため、action_id / value は付けない (Bot はボタン操作を受け取らない)
+	var buttons []slack.BlockElement
+	if paper.URL != "" {
+		buttons = append(buttons, slack.NewButtonBlockElement("", "",
+			slack.NewTextBlockObject(slack.PlainTextType, sourceLabel(paper), false, false),
+		).WithURL(paper.URL).WithStyle(slack.StylePrimary))
+	}
+	if pdf := paper.PDFURL; pdf != "" {
+		buttons = append(buttons, slack.NewButtonBlockElement("", "",
+			slack.NewTextBlockObject(slack.PlainTextType, "PDF", false, false),
+		).WithURL(pdf))
+	}
+	if len(buttons) > 0 {
+		blocks = append(blocks, slack.NewActionBlock("", buttons...))
+	}
+
+	return blocks
+}
+
+// escapeSlack は mrkdwn の制御文字 (&, <, >) をエスケープします。
+func escapeSlack(s string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;").Replace(s)
+}
@@ -0,0 +1,150 @@
+package formatter
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+	"github.com/slack-go/slack"
+)
+
+func TestSlackFormatter_Blocks(t *testing.T) {
+	venue := config.VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025}
+	p := &paper.Paper{
+		ID:       "PID",
+		URL:      "https://openreview.net/forum?id=PID",
+		Title:    "A <Great> Paper",
+		Authors:  []string{"Alice", "Bob"},
+		Abstract: "english abstract",
+		PDFURL:   "https://openreview.net/pdf?id=PID",
+	}
+
+	t.Run("layout is header, info, abstract, context, actions", func(t *testing.T) {
+		msg := NewSlackFormatter().Format(p, venue, 100, "")
+
+		wantTypes := []slack.MessageBlockType{
+			slack.MBTHeader, slack.MBTSection, slack.MBTSection, slack.MBTContext, slack.MBTAction,
+		}
+		if len(msg.Blocks) != len(wantTypes) {
+			t.Fatalf("expected %d blocks, got %d", len(wantTypes), len(msg.Blocks))
+		}
+		for i, want := range wantTypes {
+			if got := msg.Blocks[i].BlockType(); got != want {
+				t.Errorf("block %d: expected type %s, got %s", i, want, got)
+			}
+		}
+
+		header := msg.Blocks[0].(*slack.HeaderBlock)
+		if header.Text.Text != "📄 今日の論文 (ICLR 2025)" {
+			t.Errorf("unexpected header text: %q", header.Text.Text)
+		}
+
+		info := msg.Blocks[1].(*slack.SectionBlock)
+		if len(info.Fields) != 2 {
+			t.Fatalf("expected 2 fields, got %d", len(info.Fields))
+		}
+		if info.Fields[0].Text != "*Title*\n<https://openreview.net/forum?id=PID|A &lt;Great&gt; Paper>" {
+			t.Errorf("unexpected title field: %q", info.Fields[0].Text)
+		}
+		if info.Fields[1].Text != "*Authors*\nAlice, Bob" {
+			t.Errorf("unexpected authors field: %q", info.Fields[1].Text)
+		}
+
+		abs := msg.Blocks[2].(*slack.SectionBlock)
+		if abs.Text.Text != "*Abstract*:\nenglish abstract" {
+			t.Errorf("unexpected abstract text: %q", abs.Text.Text)
+		}
+
+		actions := msg.Blocks[4].(*slack.ActionBlock)
+		if len(actions.Elements.ElementSet) != 2 {
+			t.Fatalf("expected 2 buttons, got %d", len(actions.Elements.ElementSet))
+		}
+		forum := actions.Elements.ElementSet[0].(*slack.ButtonBlockElement)
+		if forum.URL != "https://openreview.net/forum?id=PID" {
+			t.Errorf("unexpected forum button URL: %q", forum.URL)
+		}
+		pdf := actions.Elements.ElementSet[1].(*slack.ButtonBlockElement)
+		if pdf.URL != "https://openreview.net/pdf?id=PID" {
+			t.Errorf("unexpected PDF button URL: %q", pdf.URL)
+		}
+		for _, b := range []*slack.ButtonBlockElement{forum, pdf} {
+			if b.ActionID != "" || b.Value != "" {
+				t.Errorf("expected URL-only button without action_id/value, got %q / %q", b.ActionID, b.Value)
+			}
+		}
+	})
+
+	t.Run("tldr section and keywords in context", func(t *testing.T) {
+		rich := *p
+		rich.TLDR = "Short <summary>."
+		rich.Keywords = []string{"rl", "vision"}
+		rich.PrimaryArea = "reinforcement learning"
+		msg := NewSlackFormatter().Format(&rich, venue, 100, "")
+
+		if len(msg.Blocks) != 6 {
+			t.Fatalf("expected 6 blocks, got %d", len(msg.Blocks))
+		}
+		tldr := msg.Blocks[2].(*slack.SectionBlock)
+		if tldr.Text.Text != "*TL;DR*\nShort &lt;summary&gt;." {
+			t.Errorf("unexpected TL;DR section: %q", tldr.Text.Text)
+		}
+		ctx := msg.Blocks[4].(*slack.ContextBlock)
+		if len(ctx.ContextElements.Elements) != 4 {
+			t.Fatalf("expected 4 context elements, got %d", len(ctx.ContextElements.Elements))
+		}
+		if got := ctx.ContextElements.Elements[1].(*slack.TextBlockObject).Text; got != "reinforcement learning" {
+			t.Errorf("unexpected primary area: %q", got)
+		}
+		if got := ctx.ContextElements.Elements[2].(*slack.TextBlockObject).Text; got != "🏷 rl, vision" {
+			t.Errorf("unexpected keywords: %q", got)
+		}
+	})
+
+	t.Run("reviews are added to the info section", func(t *testing.T) {
+		reviewed := *p
+		reviewed.Reviews = &paper.Reviews{Decision: "Accept (Spotlight)", NumReviews: 3, Ratings: []float64{6, 8, 7}, Confidences: []float64{3, 4, 4}}
+		info := NewSlackFormatter().Format(&reviewed, venue, 100, "").Blocks[1].(*slack.SectionBlock)
+		if len(info.Fields) != 4 {
+			t.Fatalf("expected 4 fields, got %d", len(info.Fields))
+		}
+		if info.Fields[2].Text != "*Decision*\nAccept (Spotlight)" {
+			t.Errorf("unexpected decision field: %q", info.Fields[2].Text)
+		}
+		if info.Fields[3].Text != "*Rating*\n7.0 (3 reviews, confidence 3.7)" {
+			t.Errorf("unexpected rating field: %q", info.Fields[3].Text)
+		}
+	})
+
+	t.Run("plain text fallback is retained", func(t *testing.T) {
+		msg := NewSlackFormatter().Format(p, venue, 100, "")
+		if !strings.Contains(msg.Main, "<https://openreview.net/forum?id=PID|📄 今日の論文 (ICLR 2025)>") {
+			t.Errorf("expected Main to keep plain text fallback.\nGot: %s", msg.Main)
+		}
+	})
+
+	t.Run("translated abstract is shown in blocks", func(t *testing.T) {
+		msg := NewSlackFormatter().Format(p, venue, 100, "日本語訳")
+		abs := msg.Blocks[2].(*slack.SectionBlock)
+		if abs.Text.Text != "*Abstract (日本語)*:\n日本語訳" {
+			t.Errorf("unexpected abstract text: %q", abs.Text.Text)
+		}
+	})
+
+	t.Run("no PDF means single button", func(t *testing.T) {
+		noPDF := *p
+		noPDF.PDFURL = ""
+		msg := NewSlackFormatter().Format(&noPDF, venue, 100, "")
+		actions := msg.Blocks[len(msg.Blocks)-1].(*slack.ActionBlock)
+		if len(actions.Elements.ElementSet) != 1 {
+			t.Errorf("expected 1 button, got %d", len(actions.Elements.ElementSet))
+		}
+	})
+
+	t.Run("Discord does not produce blocks", func(t *testing.T) {
+		msg := NewDiscordFormatter().Format(p, venue, 100, "")
+		if len(msg.Blocks) != 0 {
+			t.Errorf("expected no blocks for Discord, got %d", len(msg.Blocks))
+		}
+	})
+}
@@ -0,0 +1,154 @@
+package formatter
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+)
+
+// テンプレートで定義する名前です。ユーザーテンプレートは必要なものだけを上書きできます。
+const (
+	templateHeader   = "header"   // 見出しのプレーンテキスト (Main の先頭、Slack の header ブロック、Discord embed の footer)
+	templateAbstract = "abstract" // Abstract 欄 (Main、Slack の Abstract セクション、Discord embed の description)
+	templateReviews  = "reviews"  // 採否と査読スコア (Main)。SHOW_REVIEWS が無効、または査読が非公開の場合は Reviews が nil
+	templateMain     = "main"     // 親メッセージ (Message.Main)
+	templateSub      = "sub"      // 補助メッセージ (Message.Sub)。空文字を出力した場合は投稿しない
+)
+
+// keywordsMaxChars は TemplateData.Keywords の最大文字数です。
+const keywordsMaxChars = 300
+
+//go:embed templates/*.tmpl
+var defaultTemplates embed.FS
+
+// TemplateData はメッセージテンプレートに渡すデータです。
+//
+// Authors・Abstract・JaAbstract はプラットフォームの文字数上限と ABSTRACT_MAX_CHARS に合わせて省略済みの値です。
+// 省略前の値は Paper から参照できます。
+type TemplateData struct {
+	Paper      *paper.Paper       // 論文 (例: {{.Paper.ID}}, {{.Paper.Title}}, {{index .Paper.Metadata "_bibtex"}})
+	Venue      config.VenueConfig // 学会 (例: {{.Venue.Name}}, {{.Venue.Year}})
+	Title      string             // 論文タイトル
+	Authors    string             // カンマ区切りの著者リスト。長すぎる場合は "et al." で省略される
+	Abstract   string             // 原文の Abstract
+	JaAbstract string             // 翻訳済みの Abstract。翻訳していない場合は空文字
+	ForumURL   string             // 論文ページ (OpenReview のフォーラムページまたは arXiv の abs ページ)
+	PDFURL     string             // PDF の URL。PDF がない場合は空文字
+
+	TLDR        string // TL;DR。ない場合は空文字
+	Keywords    string // カンマ区切りのキーワード。長すぎる場合は省略される
+	PrimaryArea string // 主分野 (primary_area)。ない場合は空文字
+
+	Reviews *paper.Reviews // 採否と査読スコア (例: {{.Reviews.Decision}}, {{printf "%.1f" .Reviews.MeanRating}})。ない場合は nil
+}
+
+// templateFuncs はテンプレート内で使える関数です。
+var templateFuncs = template.FuncMap{
+	"join":     strings.Join,   // {{join .Paper.Authors ", "}}
+	"truncate": truncateWithin, // {{truncate .Title 50}}
+}
+
+// messageTemplate はプラットフォーム用のテンプレートです。
+// ユーザーテンプレートの実行に失敗した場合はデフォルトテンプレートで出力します。
+type messageTemplate struct {
+	tmpl     *template.Template
+	fallback *template.Template
+}
+
+// parseDefaultTemplate は埋め込まれたデフォルトテンプレート (templates/<platform>.tmpl) を読み込みます。
+func parseDefaultTemplate(platform string) *template.Template {
+	return template.Must(template.New(platform).Funcs(templateFuncs).ParseFS(defaultTemplates, "templates/"+platform+".tmpl"))
+}
+
+// newMessageTemplate はデフォルトテンプレートに path のユーザーテンプレートを重ねて読み込みます。
+// path が空の場合はデフォルトテンプレートのみを使います。
+// 読み込み時にサンプルデータで実行し、存在しないフィールドの参照などを検出します。
+func newMessageTemplate(platform, path string) (*messageTemplate, error) {
+	fallback := parseDefaultTemplate(platform)
+	if path == "" {
+		return &messageTemplate{tmpl: fallback, fallback: fallback}, nil
+	}
+
+	src, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read template file %s: %w", path, err)
+	}
+	tmpl, err := parseDefaultTemplate(platform).Parse(string(src))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template file %s: %w", path, err)
+	}
+
+	sample := sampleTemplateData()
+	for _, name := range []string{templateHeader, templateAbstract, templateReviews, templateMain, templateSub} {
+		if err := tmpl.ExecuteTemplate(&bytes.Buffer{}, name, sample); err != nil {
+			return nil, fmt.Errorf("failed to execute template %q in %s: %w", name, path, err)
+		}
+	}
+	return &messageTemplate{tmpl: tmpl, fallback: fallback}, nil
+}
+
+// render は名前付きテンプレートを実行します。
+func (m *messageTemplate) render(name string, data TemplateData) string {
+	var buf bytes.Buffer
+	if err := m.tmpl.ExecuteTemplate(&buf, name, data); err != nil {
+		log.Printf("WARN: failed to execute template %q, using default: %v", name, err)
+		buf.Reset()
+		if err := m.fallback.ExecuteTemplate(&buf, name, data); err != nil {
+			log.Printf("WARN: failed to execute default template %q: %v", name, err)
+			return ""
+		}
+	}
+	return buf.String()
+}
+
+// newTemplateData は論文情報から省略前の TemplateData を組み立てます。
+func newTemplateData(paper *paper.Paper, venue config.VenueConfig, jaAbstract string) TemplateData {
+	return TemplateData{
+		Paper:       paper,
+		Venue:       venue,
+		Title:       paper.Title,
+		Authors:     strings.Join(paper.Authors, ", "),
+		Abstract:    paper.Abstract,
+		JaAbstract:  jaAbstract,
+		ForumURL:    paper.URL,
+		PDFURL:      paper.PDFURL,
+		TLDR:        paper.TLDR,
+		Keywords:    truncateWithin(strings.Join(paper.Keywords, ", "), keywordsMaxChars),
+		PrimaryArea: paper.PrimaryArea,
+		Reviews:     displayedReviews(paper),
+	}
+}
+
+// displayedReviews は表示する査読結果を返します。取得していない場合や表示できる内容がない場合は nil を返します。
+func displayedReviews(paper *paper.Paper) *paper.Reviews {
+	if paper.Reviews.IsEmpty() {
+		return nil
+	}
+	return paper.Reviews
+}
+
+// sampleTemplateData はテンプレートの検証に使うサンプルデータです。
+func sampleTemplateData() TemplateData {
+	sample := &paper.Paper{
+		ID:          "SAMPLE",
+		Source:      config.SourceOpenReview,
+		Title:       "Sample Title",
+		Authors:     []string{"Alice", "Bob"},
+		Abstract:    "Sample abstract.",
+		TLDR:        "Sample TL;DR.",
+		Keywords:    []string{"sample"},
+		PrimaryArea: "sample area",
+		URL:         "https://openreview.net/forum?id=SAMPLE",
+		PDFURL:      "https://openreview.net/pdf?id=SAMPLE",
+		Reviews:     &paper.Reviews{Decision: "Accept (Oral)", NumReviews: 2, Ratings: []float64{8, 6}, Confidences: []float64{4, 3}},
+		Metadata:    map[string]string{},
+	}
+	return newTemplateData(sample, config.VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025}, "サンプル")
+}
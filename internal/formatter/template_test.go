@@ -0,0 +1,194 @@
+package formatter
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+	"github.com/slack-go/slack"
+)
+
+// go test ./internal/formatter -update でゴールデンファイルを更新します。
+var update = flag.Bool("update", false, "update golden files")
+
+func goldenPaper() *paper.Paper {
+	return &paper.Paper{
+		ID:       "PID",
+		URL:      "https://openreview.net/forum?id=PID",
+		Title:    "A Great Paper",
+		Authors:  []string{"Alice", "Bob", "Carol"},
+		Abstract: "We propose a great method.",
+		PDFURL:   "https://openreview.net/pdf?id=PID",
+	}
+}
+
+func goldenReviews() *paper.Reviews {
+	return &paper.Reviews{Decision: "Accept (Oral)", NumReviews: 4, Ratings: []float64{8, 8, 6, 6}, Confidences: []float64{4, 3, 4, 4}}
+}
+
+func assertGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatalf("failed to update golden file: %v", err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("failed to read golden file: %v", err)
+	}
+	if got != string(want) {
+		t.Errorf("output does not match %s.\nGot:\n%s\nWant:\n%s", path, got, want)
+	}
+}
+
+func TestDefaultTemplates_Golden(t *testing.T) {
+	venue := config.VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025}
+
+	testCases := []struct {
+		name       string
+		formatter  Formatter
+		jaAbstract string
+		reviews    *paper.Reviews
+		rich       bool // TL;DR・キーワードを含む
+	}{
+		{name: "discord", formatter: NewDiscordFormatter()},
+		{name: "discord_translated", formatter: NewDiscordFormatter(), jaAbstract: "すごい手法を提案します。"},
+		{name: "discord_reviews", formatter: NewDiscordFormatter(), reviews: goldenReviews()},
+		{name: "discord_rich", formatter: NewDiscordFormatter(), rich: true},
+		{name: "slack", formatter: NewSlackFormatter()},
+		{name: "slack_translated", formatter: NewSlackFormatter(), jaAbstract: "すごい手法を提案します。"},
+		{name: "slack_reviews", formatter: NewSlackFormatter(), reviews: goldenReviews()},
+		{name: "slack_rich", formatter: NewSlackFormatter(), rich: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			paper := goldenPaper()
+			paper.Reviews = tc.reviews
+			if tc.rich {
+				paper.TLDR = "A great method in one sentence."
+				paper.Keywords = []string{"reinforcement learning", "vision"}
+			}
+			msg := tc.formatter.Format(paper, venue, 100, tc.jaAbstract)
+			assertGolden(t, tc.name+"_main", msg.Main)
+			if tc.jaAbstract != "" {
+				assertGolden(t, tc.name+"_sub", msg.Sub)
+			} else if msg.Sub != "" {
+				t.Errorf("expected empty Sub, got %q", msg.Sub)
+			}
+		})
+	}
+}
+
+func TestFormatterFromTemplate(t *testing.T) {
+	venue := config.VenueConfig{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Year: 2025}
+
+	writeTemplate := func(t *testing.T, src string) string {
+		t.Helper()
+		path := filepath.Join(t.TempDir(), "custom.tmpl")
+		if err := os.WriteFile(path, []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	t.Run("overrides only the defined templates", func(t *testing.T) {
+		path := writeTemplate(t, `{{define "header"}}Paper of the day: {{.Venue.Name}}{{end}}`)
+		f, err := NewSlackFormatterFromTemplate(path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		msg := f.Format(goldenPaper(), venue, 100, "")
+
+		if !strings.HasPrefix(msg.Main, "<https://openreview.net/forum?id=PID|Paper of the day: ICLR>") {
+			t.Errorf("expected custom header in Main.\nGot: %s", msg.Main)
+		}
+		if !strings.Contains(msg.Main, "*Authors*: Alice, Bob, Carol") {
+			t.Errorf("expected default main body to be kept.\nGot: %s", msg.Main)
+		}
+		header := msg.Blocks[0].(*slack.HeaderBlock)
+		if header.Text.Text != "Paper of the day: ICLR" {
+			t.Errorf("expected custom header block, got %q", header.Text.Text)
+		}
+	})
+
+	t.Run("custom main and sub with functions", func(t *testing.T) {
+		path := writeTemplate(t, `
+{{define "main"}}{{.Title}} / {{join .Paper.Authors " & "}} / {{truncate .Abstract 10}}{{end}}
+{{define "sub"}}{{if .JaAbstract}}Original: {{.Abstract}}{{end}}{{end}}`)
+		f, err := NewDiscordFormatterFromTemplate(path)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		msg := f.Format(goldenPaper(), venue, 100, "訳")
+		if msg.Main != "A Great Paper / Alice & Bob & Carol / We prop..." {
+			t.Errorf("unexpected Main: %q", msg.Main)
+		}
+		if msg.Sub != "Original: We propose a great method." {
+			t.Errorf("unexpected Sub: %q", msg.Sub)
+		}
+		if msg.Embeds[0].Description != "*Abstract (日本語)*:\n訳" {
+			t.Errorf("expected default abstract in embed, got %q", msg.Embeds[0].Description)
+		}
+	})
+
+	t.Run("empty path uses defaults", func(t *testing.T) {
+		f, err := NewDiscordFormatterFromTemplate("")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := f.Format(goldenPaper(), venue, 100, "")
+		want := NewDiscordFormatter().Format(goldenPaper(), venue, 100, "")
+		if got.Main != want.Main {
+			t.Errorf("expected default output.\nGot: %s\nWant: %s", got.Main, want.Main)
+		}
+	})
+
+	t.Run("text only posts the custom main as content", func(t *testing.T) {
+		path := writeTemplate(t, `{{define "main"}}論文: {{.Title}}
+著者: {{.Authors}}{{end}}`)
+		for name, newFormatter := range map[string]func(string) (Formatter, error){
+			"slack":   NewSlackFormatterFromTemplate,
+			"discord": NewDiscordFormatterFromTemplate,
+		} {
+			f, err := newFormatter(path)
+			if err != nil {
+				t.Fatalf("%s: unexpected error: %v", name, err)
+			}
+			msg := TextOnly(f).Format(goldenPaper(), venue, 100, "")
+			if msg.Main != "論文: A Great Paper\n著者: Alice, Bob, Carol" {
+				t.Errorf("%s: unexpected Main: %q", name, msg.Main)
+			}
+			if msg.Blocks != nil || msg.Embeds != nil {
+				t.Errorf("%s: expected no Blocks or Embeds, got %d blocks and %d embeds", name, len(msg.Blocks), len(msg.Embeds))
+			}
+		}
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		if _, err := NewSlackFormatterFromTemplate(filepath.Join(t.TempDir(), "missing.tmpl")); err == nil {
+			t.Error("expected error for missing file")
+		}
+	})
+
+	t.Run("syntax error", func(t *testing.T) {
+		path := writeTemplate(t, `{{define "main"}}{{.Title}{{end}}`)
+		if _, err := NewSlackFormatterFromTemplate(path); err == nil {
+			t.Error("expected parse error")
+		}
+	})
+
+	t.Run("unknown field is detected on load", func(t *testing.T) {
+		path := writeTemplate(t, `{{define "main"}}{{.NoSuchField}}{{end}}`)
+		if _, err := NewDiscordFormatterFromTemplate(path); err == nil {
+			t.Error("expected execution error")
+		}
+	})
+}
@@ -0,0 +1,18 @@
+package history
+
+// Entry は投稿済み論文1件分の記録です。
+type Entry struct {
+	Date     string `json:"date"`               // 投稿日 (YYYY-MM-DD)
+	Venue    string `json:"venue"`              // API用Venue ID
+	Platform string `json:"platform,omitempty"` // 投稿先 (slack / discord)
+}
+
+// Store は投稿済み論文の履歴を永続化するインターフェースです。
+type Store interface {
+	// Load は永続化先から履歴を読み込みます。
+	Load() error
+	// Record は論文を投稿済みとして記録し、永続化します。
+	Record(paperID string, entry Entry) error
+	// Contains は論文が投稿済みかどうかを返します。
+	Contains(paperID string) bool
+}
@@ -0,0 +1,84 @@
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/atomicfile"
+)
+
+// postedFile は data/posted.json のトップレベル構造です。
+type postedFile struct {
+	Posted map[string]Entry `json:"posted"`
+}
+
+// JSONStore は投稿履歴をJSONファイルに保存する Store 実装です。
+type JSONStore struct {
+	path   string
+	posted map[string]Entry
+}
+
+// NewJSONStore は指定パスのJSONファイルを扱う JSONStore を生成します。
+func NewJSONStore(path string) *JSONStore {
+	return &JSONStore{
+		path:   path,
+		posted: map[string]Entry{},
+	}
+}
+
+// Load はJSONファイルから履歴を読み込みます。ファイルが存在しない場合は空の履歴として扱います。
+func (s *JSONStore) Load() error {
+	bytes, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		s.posted = map[string]Entry{}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read history file at %s: %w", s.path, err)
+	}
+
+	var file postedFile
+	if err := json.Unmarshal(bytes, &file); err != nil {
+		return fmt.Errorf("failed to parse history file: %w", err)
+	}
+	if file.Posted == nil {
+		file.Posted = map[string]Entry{}
+	}
+	s.posted = file.Posted
+	return nil
+}
+
+// Record は論文を履歴に追加し、JSONファイルに書き出します。
+func (s *JSONStore) Record(paperID string, entry Entry) error {
+	if paperID == "" {
+		return errors.New("paper id must not be empty")
+	}
+	s.posted[paperID] = entry
+	return s.save()
+}
+
+// Contains は論文が履歴に含まれるかどうかを返します。
+func (s *JSONStore) Contains(paperID string) bool {
+	_, ok := s.posted[paperID]
+	return ok
+}
+
+// Entries は全ての履歴を論文IDをキーとするマップで返します。返されたマップを変更しても履歴には影響しません。
+func (s *JSONStore) Entries() map[string]Entry {
+	entries := make(map[string]Entry, len(s.posted))
+	for id, e := range s.posted {
+		entries[id] = e
+	}
+	return entries
+}
+
+// save は一時ファイルに書き出してからリネームし、書き込み途中のファイルが残らないようにします。
+func (s *JSONStore) save() error {
+	if err := atomicfile.WriteJSON(s.path, postedFile{Posted: s.posted}); err != nil {
+		return fmt.Errorf("failed to save history: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package history
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestJSONStore_Load(t *testing.T) {
+	t.Run("missing file is treated as empty history", func(t *testing.T) {
+		store := NewJSONStore(filepath.Join(t.TempDir(), "posted.json"))
+		if err := store.Load(); err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if store.Contains("p1") {
+			t.Error("expected empty history")
+		}
+	})
+
+	t.Run("reads spec format keyed by paper id", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "posted.json")
+		content := `{"posted":{"p1":{"date":"2026-01-31","venue":"ICLR.cc/2025/Conference"}}}`
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("failed to write fixture: %v", err)
+		}
+
+		store := NewJSONStore(path)
+		if err := store.Load(); err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+		if !store.Contains("p1") {
+			t.Error("expected p1 to be contained")
+		}
+		if store.Contains("p2") {
+			t.Error("expected p2 not to be contained")
+		}
+	})
+
+	t.Run("invalid json returns error", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "posted.json")
+		if err := os.WriteFile(path, []byte(`{"posted":`), 0644); err != nil {
+			t.Fatalf("failed to write fixture: %v", err)
+		}
+
+		if err := NewJSONStore(path).Load(); err == nil {
+			t.Error("expected error for invalid JSON")
+		}
+	})
+}
+
+func TestJSONStore_Record(t *testing.T) {
+	t.Run("record persists entry to file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "data", "posted.json")
+		store := NewJSONStore(path)
+		if err := store.Load(); err != nil {
+			t.Fatalf("Load() failed: %v", err)
+		}
+
+		entry := Entry{Date: "2026-01-31", Venue: "ICLR.cc/2025/Conference", Platform: "slack"}
+		if err := store.Record("p1", entry); err != nil {
+			t.Fatalf("Record() failed: %v", err)
+		}
+		if !store.Contains("p1") {
+			t.Error("expected p1 to be contained after Record")
+		}
+
+		bytes, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatalf("failed to read history file: %v", err)
+		}
+		var file postedFile
+		if err := json.Unmarshal(bytes, &file); err != nil {
+			t.Fatalf("history file is not valid JSON: %v", err)
+		}
+		if file.Posted["p1"] != entry {
+			t.Errorf("expected entry %+v, got %+v", entry, file.Posted["p1"])
+		}
+
+		reloaded := NewJSONStore(path)
+		if err := reloaded.Load(); err != nil {
+			t.Fatalf("Load() after Record failed: %v", err)
+		}
+		if !reloaded.Contains("p1") {
+			t.Error("expected p1 to survive reload")
+		}
+	})
+
+	t.Run("empty paper id is rejected", func(t *testing.T) {
+		store := NewJSONStore(filepath.Join(t.TempDir(), "posted.json"))
+		if err := store.Record("", Entry{}); err == nil {
+			t.Error("expected error for empty paper id")
+		}
+	})
+}
+
+func TestJSONStore_Entries(t *testing.T) {
+	store := NewJSONStore(filepath.Join(t.TempDir(), "posted.json"))
+	if err := store.Load(); err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if err := store.Record("p1", Entry{Date: "2026-01-31", Venue: "ICLR.cc/2025/Conference"}); err != nil {
+		t.Fatalf("Record() failed: %v", err)
+	}
+
+	entries := store.Entries()
+	if len(entries) != 1 || entries["p1"].Date != "2026-01-31" {
+		t.Fatalf("unexpected entries: %+v", entries)
+	}
+
+	delete(entries, "p1")
+	if !store.Contains("p1") {
+		t.Error("modifying the returned map must not affect the history")
+	}
+}
@@ -2,55 +2,174 @@ package notifier
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"log"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/hayashi-yaken/daily-paper-bot/internal/formatter"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
 )
 
+// msg.Sub の投稿方法です。
+const (
+	DiscordSubFollowUp = "followup" // 同じチャンネルに後続メッセージとして投稿する
+	// thread_name でスレッド (フォーラム投稿) を作成し、その中に投稿する。
+	// thread_name を受け付けるのはフォーラムチャンネルの Webhook のみで、テキストチャンネルでは 400 になるため followup にフォールバックする
+	DiscordSubThread = "thread"
+)
+
+// discordThreadNameMaxChars はスレッド名の文字数上限です。
+const discordThreadNameMaxChars = 100
+
 // DiscordNotifier はDiscordのWebhookにメッセージを投稿します。
 type DiscordNotifier struct {
 	webhookURL string
 	httpClient *http.Client
+	retry      retry.Policy
+	SubMode    string // DiscordSubFollowUp (デフォルト) または DiscordSubThread (フォーラムチャンネルの Webhook のみ)
+}
+
+// discordStatusError は Webhook が 2xx 以外のステータスを返したことを表します。
+type discordStatusError struct {
+	StatusCode int
+}
+
+func (e *discordStatusError) Error() string {
+	return fmt.Sprintf("discord webhook returned non-2xx status: %d", e.StatusCode)
 }
 
 // NewDiscordNotifier は新しいDiscordNotifierを生成します。
-func NewDiscordNotifier(webhookURL string) *DiscordNotifier {
+// Webhook への POST は冪等ではないため、リトライは 429 (レート制限) の場合のみ行われます。
+func NewDiscordNotifier(webhookURL string, retryPolicy retry.Policy) *DiscordNotifier {
 	return &DiscordNotifier{
 		webhookURL: webhookURL,
 		httpClient: &http.Client{Timeout: 10 * time.Second},
+		retry:      retryPolicy,
+		SubMode:    DiscordSubFollowUp,
 	}
 }
 
 // discordPayload はDiscord Webhookに送信するJSONの構造体です。
 type discordPayload struct {
-	Content string `json:"content"`
+	Content    string                   `json:"content,omitempty"`
+	Embeds     []formatter.DiscordEmbed `json:"embeds,omitempty"`
+	ThreadName string                   `json:"thread_name,omitempty"`
+}
+
+// discordMessage は wait=true で投稿した場合に返されるメッセージオブジェクトです。
+type discordMessage struct {
+	ID        string `json:"id"`
+	ChannelID string `json:"channel_id"`
 }
 
 // Post は指定されたメッセージをDiscordのWebhookに投稿します。
-func (n *DiscordNotifier) Post(msg formatter.Message) error {
+// msg.Embeds がある場合は embed を、ない場合は msg.Main を content として投稿します。
+// msg.Sub は SubMode に従って後続メッセージまたはスレッド内に投稿し、その失敗は WARN ログのみとします。
+// スレッドを作成できない場合 (テキストチャンネルの Webhook が thread_name を 400 で拒否した場合など) は、followup として投稿します。
+func (n *DiscordNotifier) Post(ctx context.Context, msg formatter.Message) error {
 	payload := discordPayload{Content: msg.Main}
+	if len(msg.Embeds) > 0 {
+		payload = discordPayload{Embeds: msg.Embeds}
+	}
+
+	// フォーラムチャンネルへの Webhook は thread_name が必須のため、Sub の有無に関わらず指定する
+	useThread := n.SubMode == DiscordSubThread
+	query := url.Values{}
+	if useThread {
+		payload.ThreadName = threadName(msg)
+		query.Set("wait", "true") // 作成されたスレッドの ID を受け取る
+	}
+
+	parent, err := n.execute(ctx, payload, query)
+	var statusErr *discordStatusError
+	if useThread && errors.As(err, &statusErr) && statusErr.StatusCode == http.StatusBadRequest {
+		// 400 の場合は投稿されていないため、thread_name を外して送り直しても二重投稿にならない
+		log.Printf("WARN: discord rejected thread_name (only forum channel webhooks accept it); falling back to followup mode: %v", err)
+		useThread = false
+		payload.ThreadName = ""
+		parent, err = n.execute(ctx, payload, url.Values{})
+	}
+	if err != nil {
+		return err
+	}
+
+	if msg.Sub == "" {
+		return nil
+	}
+
+	subQuery := url.Values{}
+	if useThread {
+		if parent == nil || parent.ChannelID == "" {
+			log.Printf("WARN: discord did not return thread id; posting sub message as a followup")
+		} else {
+			subQuery.Set("thread_id", parent.ChannelID)
+		}
+	}
+	if _, subErr := n.execute(ctx, discordPayload{Content: msg.Sub}, subQuery); subErr != nil {
+		log.Printf("WARN: failed to post sub message to discord (parent succeeded): %v", subErr)
+	}
+	return nil
+}
+
+// execute は Webhook を1回実行します。query に wait=true を含む場合は投稿されたメッセージを返します。
+func (n *DiscordNotifier) execute(ctx context.Context, payload discordPayload, query url.Values) (*discordMessage, error) {
 	jsonPayload, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("failed to marshal discord payload: %w", err)
+		return nil, fmt.Errorf("failed to marshal discord payload: %w", err)
+	}
+
+	endpoint, err := url.Parse(n.webhookURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse discord webhook url: %w", err)
 	}
+	q := endpoint.Query()
+	for k, v := range query {
+		q[k] = v
+	}
+	endpoint.RawQuery = q.Encode()
 
-	req, err := http.NewRequest("POST", n.webhookURL, bytes.NewBuffer(jsonPayload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewBuffer(jsonPayload))
 	if err != nil {
-		return fmt.Errorf("failed to create discord request: %w", err)
+		return nil, fmt.Errorf("failed to create discord request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := n.httpClient.Do(req)
+	resp, err := n.retry.Do(n.httpClient, req)
 	if err != nil {
-		return fmt.Errorf("failed to post message to discord: %w", err)
+		return nil, fmt.Errorf("failed to post message to discord: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return fmt.Errorf("discord webhook returned non-2xx status: %d", resp.StatusCode)
+		return nil, &discordStatusError{StatusCode: resp.StatusCode}
 	}
-	return nil
+
+	if query.Get("wait") != "true" {
+		return nil, nil
+	}
+	var posted discordMessage
+	if err := json.NewDecoder(resp.Body).Decode(&posted); err != nil {
+		// 投稿自体は成功しているため、エラーにはしない
+		log.Printf("WARN: failed to decode discord response: %v", err)
+		return nil, nil
+	}
+	return &posted, nil
+}
+
+// threadName はスレッド名として使う文字列を返します。
+func threadName(msg formatter.Message) string {
+	name := msg.Title
+	if name == "" {
+		name = "今日の論文"
+	}
+	runes := []rune(name)
+	if len(runes) > discordThreadNameMaxChars {
+		name = string(runes[:discordThreadNameMaxChars-3]) + "..."
+	}
+	return name
 }
@@ -3,8 +3,12 @@
 package notifier
 
 import (
+	"context"
 	"os"
 	"testing"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/formatter"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
 )
 
 func TestDiscordNotifier_Integration_Post(t *testing.T) {
@@ -14,10 +18,10 @@ func TestDiscordNotifier_Integration_Post(t *testing.T) {
 		t.Skip("DISCORD_WEBHOOK_URL must be set for integration tests")
 	}
 
-	notifier := NewDiscordNotifier(webhookURL)
+	notifier := NewDiscordNotifier(webhookURL, retry.NoRetry())
 	message := "This is an integration test message for Discord from the Daily Paper Bot."
 
-	err := notifier.Post(message)
+	err := notifier.Post(context.Background(), formatter.Message{Main: message})
 	if err != nil {
 		t.Fatalf("Failed to post message to Discord: %v", err)
 	}
@@ -1,13 +1,17 @@
 package notifier
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hayashi-yaken/daily-paper-bot/internal/formatter"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
 )
 
 func TestDiscordNotifier_Post(t *testing.T) {
@@ -19,8 +23,8 @@ func TestDiscordNotifier_Post(t *testing.T) {
 		}))
 		defer server.Close()
 
-		notifier := NewDiscordNotifier(server.URL)
-		err := notifier.Post(formatter.Message{Main: "hello", Sub: "ignored"})
+		notifier := NewDiscordNotifier(server.URL, retry.NoRetry())
+		err := notifier.Post(context.Background(), formatter.Message{Main: "hello"})
 		if err != nil {
 			t.Errorf("Post() should not return an error, but got: %v", err)
 		}
@@ -36,22 +40,218 @@ func TestDiscordNotifier_Post(t *testing.T) {
 		}
 	})
 
+	t.Run("embeds replace content", func(t *testing.T) {
+		var receivedBody []byte
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedBody, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		notifier := NewDiscordNotifier(server.URL, retry.NoRetry())
+		msg := formatter.Message{
+			Main:   "plain",
+			Embeds: []formatter.DiscordEmbed{{Title: "T", URL: "https://openreview.net/forum?id=PID", Color: 0x123456}},
+		}
+		if err := notifier.Post(context.Background(), msg); err != nil {
+			t.Fatalf("Post() returned error: %v", err)
+		}
+
+		var payload struct {
+			Content string                   `json:"content"`
+			Embeds  []formatter.DiscordEmbed `json:"embeds"`
+		}
+		if err := json.Unmarshal(receivedBody, &payload); err != nil {
+			t.Fatalf("invalid request body: %v", err)
+		}
+		if payload.Content != "" {
+			t.Errorf("expected no content when embeds are set, got %q", payload.Content)
+		}
+		if len(payload.Embeds) != 1 || payload.Embeds[0].Title != "T" || payload.Embeds[0].Color != 0x123456 {
+			t.Errorf("unexpected embeds: %+v", payload.Embeds)
+		}
+	})
+
+	t.Run("Sub is posted as follow-up message", func(t *testing.T) {
+		var bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			b, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		notifier := NewDiscordNotifier(server.URL, retry.NoRetry())
+		if err := notifier.Post(context.Background(), formatter.Message{Main: "main", Sub: "sub"}); err != nil {
+			t.Fatalf("Post() returned error: %v", err)
+		}
+		if len(bodies) != 2 {
+			t.Fatalf("expected 2 requests (main + sub), got %d", len(bodies))
+		}
+		if !strings.Contains(bodies[1], `"content":"sub"`) {
+			t.Errorf("expected second request to carry Sub, got %s", bodies[1])
+		}
+	})
+
+	t.Run("Sub is posted into thread created by thread_name", func(t *testing.T) {
+		var queries []string
+		var bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			queries = append(queries, r.URL.RawQuery)
+			b, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"m1","channel_id":"thread-123"}`))
+		}))
+		defer server.Close()
+
+		notifier := NewDiscordNotifier(server.URL, retry.NoRetry())
+		notifier.SubMode = DiscordSubThread
+		err := notifier.Post(context.Background(), formatter.Message{Main: "main", Sub: "sub", Title: "Paper Title"})
+		if err != nil {
+			t.Fatalf("Post() returned error: %v", err)
+		}
+		if len(queries) != 2 {
+			t.Fatalf("expected 2 requests, got %d", len(queries))
+		}
+		if queries[0] != "wait=true" {
+			t.Errorf("expected wait=true on parent post, got %q", queries[0])
+		}
+		if !strings.Contains(bodies[0], `"thread_name":"Paper Title"`) {
+			t.Errorf("expected thread_name in parent post, got %s", bodies[0])
+		}
+		if queries[1] != "thread_id=thread-123" {
+			t.Errorf("expected thread_id on sub post, got %q", queries[1])
+		}
+	})
+
+	t.Run("thread mode falls back to follow-up when thread_name is rejected", func(t *testing.T) {
+		var queries []string
+		var bodies []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			queries = append(queries, r.URL.RawQuery)
+			b, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(b))
+			if strings.Contains(string(b), "thread_name") {
+				// テキストチャンネルの Webhook は thread_name を受け付けない
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		notifier := NewDiscordNotifier(server.URL, retry.NoRetry())
+		notifier.SubMode = DiscordSubThread
+		err := notifier.Post(context.Background(), formatter.Message{Main: "main", Sub: "sub", Title: "Paper Title"})
+		if err != nil {
+			t.Fatalf("Post() returned error: %v", err)
+		}
+		if len(bodies) != 3 {
+			t.Fatalf("expected rejected thread post, retried parent and sub, got %d requests", len(bodies))
+		}
+		if strings.Contains(bodies[1], "thread_name") || queries[1] != "" {
+			t.Errorf("expected parent to be retried without thread_name, got %s (query %q)", bodies[1], queries[1])
+		}
+		if !strings.Contains(bodies[2], `"content":"sub"`) || queries[2] != "" {
+			t.Errorf("expected sub as a follow-up message, got %s (query %q)", bodies[2], queries[2])
+		}
+	})
+
+	t.Run("thread mode posts Sub as follow-up without thread id", func(t *testing.T) {
+		var queries []string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			queries = append(queries, r.URL.RawQuery)
+			w.Header().Set("Content-Type", "application/json")
+			_, _ = w.Write([]byte(`{"id":"m1"}`))
+		}))
+		defer server.Close()
+
+		notifier := NewDiscordNotifier(server.URL, retry.NoRetry())
+		notifier.SubMode = DiscordSubThread
+		if err := notifier.Post(context.Background(), formatter.Message{Main: "main", Sub: "sub"}); err != nil {
+			t.Fatalf("Post() returned error: %v", err)
+		}
+		if len(queries) != 2 || queries[1] != "" {
+			t.Errorf("expected sub to be posted without thread_id, got %v", queries)
+		}
+	})
+
+	t.Run("Sub failure does not fail Post", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls > 1 {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		notifier := NewDiscordNotifier(server.URL, retry.NoRetry())
+		if err := notifier.Post(context.Background(), formatter.Message{Main: "main", Sub: "sub"}); err != nil {
+			t.Errorf("Post should not return error when only Sub fails, got: %v", err)
+		}
+	})
+
 	t.Run("post failure due to server error", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			w.WriteHeader(http.StatusInternalServerError)
 		}))
 		defer server.Close()
 
-		notifier := NewDiscordNotifier(server.URL)
-		err := notifier.Post(formatter.Message{Main: "test"})
+		notifier := NewDiscordNotifier(server.URL, retry.NoRetry())
+		err := notifier.Post(context.Background(), formatter.Message{Main: "test"})
 		if err == nil {
 			t.Error("Post() should return an error for non-2xx status, but got nil")
 		}
 	})
 
+	t.Run("rate limited post is retried after Retry-After", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.Header().Set("Retry-After", "0")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+
+		policy := retry.Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second}
+		notifier := NewDiscordNotifier(server.URL, policy)
+		if err := notifier.Post(context.Background(), formatter.Message{Main: "test"}); err != nil {
+			t.Fatalf("Post() should succeed after retry, but got: %v", err)
+		}
+		if calls != 2 {
+			t.Errorf("expected 2 calls, got %d", calls)
+		}
+	})
+
+	t.Run("server error is not retried for non-idempotent webhook post", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		policy := retry.Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second}
+		notifier := NewDiscordNotifier(server.URL, policy)
+		if err := notifier.Post(context.Background(), formatter.Message{Main: "test"}); err == nil {
+			t.Error("Post() should return an error for non-2xx status, but got nil")
+		}
+		if calls != 1 {
+			t.Errorf("expected 1 call, got %d", calls)
+		}
+	})
+
 	t.Run("post failure due to invalid url", func(t *testing.T) {
-		notifier := NewDiscordNotifier("http://localhost:99999")
-		err := notifier.Post(formatter.Message{Main: "test"})
+		notifier := NewDiscordNotifier("http://localhost:99999", retry.NoRetry())
+		err := notifier.Post(context.Background(), formatter.Message{Main: "test"})
 		if err == nil {
 			t.Error("Post() should return an error for invalid URL, but got nil")
 		}
@@ -0,0 +1,41 @@
+package notifier
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/formatter"
+)
+
+// Target は投稿先1件分の Notifier と、そのプラットフォーム用の Formatter の組です。
+type Target struct {
+	Platform  string
+	Notifier  Notifier
+	Formatter formatter.Formatter
+}
+
+// Result は投稿先1件分の投稿結果です。
+type Result struct {
+	Platform string
+	Err      error
+}
+
+// PostAll は各投稿先のフォーマッタで整形したメッセージを並行して投稿し、投稿先ごとの結果を targets と同じ順序で返します。
+func PostAll(ctx context.Context, targets []Target, format func(f formatter.Formatter) formatter.Message) []Result {
+	results := make([]Result, len(targets))
+
+	var wg sync.WaitGroup
+	for i, t := range targets {
+		results[i].Platform = t.Platform
+		msg := format(t.Formatter)
+
+		wg.Add(1)
+		go func(i int, t Target, msg formatter.Message) {
+			defer wg.Done()
+			results[i].Err = t.Notifier.Post(ctx, msg)
+		}(i, t, msg)
+	}
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,100 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/formatter"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+)
+
+// recordingNotifier は受け取ったメッセージを記録するテスト用の Notifier です。
+type recordingNotifier struct {
+	mu       sync.Mutex
+	err      error
+	delay    time.Duration
+	received []formatter.Message
+}
+
+func (r *recordingNotifier) Post(_ context.Context, msg formatter.Message) error {
+	time.Sleep(r.delay)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.received = append(r.received, msg)
+	return r.err
+}
+
+// prefixFormatter は Main に接頭辞を付けるだけのテスト用の Formatter です。
+type prefixFormatter struct{ prefix string }
+
+func (f prefixFormatter) Format(paper *paper.Paper, _ config.VenueConfig, _ int, _ string) formatter.Message {
+	return formatter.Message{Main: f.prefix + paper.ID}
+}
+
+func TestPostAll(t *testing.T) {
+	paper := &paper.Paper{ID: "PID"}
+	format := func(f formatter.Formatter) formatter.Message {
+		return f.Format(paper, config.VenueConfig{}, 0, "")
+	}
+
+	t.Run("each target receives its own formatted message", func(t *testing.T) {
+		slackMock := &recordingNotifier{}
+		discordMock := &recordingNotifier{}
+		targets := []Target{
+			{Platform: "slack", Notifier: slackMock, Formatter: prefixFormatter{"slack:"}},
+			{Platform: "discord", Notifier: discordMock, Formatter: prefixFormatter{"discord:"}},
+		}
+
+		results := PostAll(context.Background(), targets, format)
+
+		if len(results) != 2 {
+			t.Fatalf("expected 2 results, got %d", len(results))
+		}
+		for _, r := range results {
+			if r.Err != nil {
+				t.Errorf("unexpected error for %s: %v", r.Platform, r.Err)
+			}
+		}
+		if len(slackMock.received) != 1 || slackMock.received[0].Main != "slack:PID" {
+			t.Errorf("unexpected slack messages: %+v", slackMock.received)
+		}
+		if len(discordMock.received) != 1 || discordMock.received[0].Main != "discord:PID" {
+			t.Errorf("unexpected discord messages: %+v", discordMock.received)
+		}
+	})
+
+	t.Run("failure of one target is reported per target", func(t *testing.T) {
+		targets := []Target{
+			{Platform: "slack", Notifier: &recordingNotifier{err: errors.New("boom")}, Formatter: prefixFormatter{}},
+			{Platform: "discord", Notifier: &recordingNotifier{}, Formatter: prefixFormatter{}},
+		}
+
+		results := PostAll(context.Background(), targets, format)
+
+		if results[0].Platform != "slack" || results[0].Err == nil {
+			t.Errorf("expected slack to fail, got %+v", results[0])
+		}
+		if results[1].Platform != "discord" || results[1].Err != nil {
+			t.Errorf("expected discord to succeed, got %+v", results[1])
+		}
+	})
+
+	t.Run("targets are posted concurrently", func(t *testing.T) {
+		delay := 100 * time.Millisecond
+		targets := []Target{
+			{Platform: "a", Notifier: &recordingNotifier{delay: delay}, Formatter: prefixFormatter{}},
+			{Platform: "b", Notifier: &recordingNotifier{delay: delay}, Formatter: prefixFormatter{}},
+			{Platform: "c", Notifier: &recordingNotifier{delay: delay}, Formatter: prefixFormatter{}},
+		}
+
+		start := time.Now()
+		PostAll(context.Background(), targets, format)
+		if elapsed := time.Since(start); elapsed >= 3*delay {
+			t.Errorf("expected concurrent posting, took %v", elapsed)
+		}
+	})
+}
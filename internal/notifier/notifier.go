@@ -1,8 +1,12 @@
 package notifier
 
-import "github.com/hayashi-yaken/daily-paper-bot/internal/formatter"
+import (
+	"context"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/formatter"
+)
 
 // Notifier はメッセージを通知する責務を持つインターフェースです。
 type Notifier interface {
-	Post(msg formatter.Message) error
+	Post(ctx context.Context, msg formatter.Message) error
 }
@@ -1,6 +1,7 @@
 package notifier
 
 import (
+	"context"
 	"fmt"
 	"log"
 
@@ -8,9 +9,9 @@ import (
 	"github.com/slack-go/slack"
 )
 
-// apiPoster は slack.Client.PostMessage を抽象化し、テストでモックできるようにするためのインターフェースです。
+// apiPoster は slack.Client.PostMessageContext を抽象化し、テストでモックできるようにするためのインターフェースです。
 type apiPoster interface {
-	PostMessage(channelID string, options ...slack.MsgOption) (string, string, error)
+	PostMessageContext(ctx context.Context, channelID string, options ...slack.MsgOption) (string, string, error)
 }
 
 // SlackNotifier はSlackにメッセージを投稿します。
@@ -29,12 +30,17 @@ func NewSlackNotifier(botToken, channelID string) *SlackNotifier {
 }
 
 // Post は指定されたメッセージをSlackチャンネルに投稿します。
-func (n *SlackNotifier) Post(msg formatter.Message) error {
-	_, parentTS, err := n.poster.PostMessage(
-		n.channelID,
+// msg.Blocks がある場合は Block Kit で投稿し、msg.Main は通知用のフォールバックテキストとして送ります。
+func (n *SlackNotifier) Post(ctx context.Context, msg formatter.Message) error {
+	options := []slack.MsgOption{
 		slack.MsgOptionText(msg.Main, false),
 		slack.MsgOptionAsUser(true),
-	)
+	}
+	if len(msg.Blocks) > 0 {
+		options = append(options, slack.MsgOptionBlocks(msg.Blocks...))
+	}
+
+	_, parentTS, err := n.poster.PostMessageContext(ctx, n.channelID, options...)
 	if err != nil {
 		return fmt.Errorf("failed to post message to slack: %w", err)
 	}
@@ -43,7 +49,8 @@ func (n *SlackNotifier) Post(msg formatter.Message) error {
 		return nil
 	}
 
-	if _, _, threadErr := n.poster.PostMessage(
+	if _, _, threadErr := n.poster.PostMessageContext(
+		ctx,
 		n.channelID,
 		slack.MsgOptionText(msg.Sub, false),
 		slack.MsgOptionAsUser(true),
@@ -3,8 +3,11 @@
 package notifier
 
 import (
+	"context"
 	"os"
 	"testing"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/formatter"
 )
 
 func TestSlackNotifier_Integration_Post(t *testing.T) {
@@ -18,7 +21,7 @@ func TestSlackNotifier_Integration_Post(t *testing.T) {
 	notifier := NewSlackNotifier(botToken, channelID)
 	message := "This is an integration test message for Slack from the Daily Paper Bot."
 
-	err := notifier.Post(message)
+	err := notifier.Post(context.Background(), formatter.Message{Main: message})
 	if err != nil {
 		t.Fatalf("Failed to post message to Slack: %v", err)
 	}
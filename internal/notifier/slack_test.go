@@ -1,7 +1,9 @@
 package notifier
 
 import (
+	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	"github.com/hayashi-yaken/daily-paper-bot/internal/formatter"
@@ -16,7 +18,7 @@ type mockAPIPoster struct {
 	}
 }
 
-func (m *mockAPIPoster) PostMessage(channelID string, options ...slack.MsgOption) (string, string, error) {
+func (m *mockAPIPoster) PostMessageContext(_ context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
 	m.calls = append(m.calls, struct {
 		channelID string
 		options   []slack.MsgOption
@@ -32,7 +34,7 @@ func TestSlackNotifier_Post(t *testing.T) {
 		mock := &mockAPIPoster{}
 		notifier := &SlackNotifier{poster: mock, channelID: "C12345"}
 
-		if err := notifier.Post(formatter.Message{Main: "hello"}); err != nil {
+		if err := notifier.Post(context.Background(), formatter.Message{Main: "hello"}); err != nil {
 			t.Fatalf("Post returned error: %v", err)
 		}
 		if len(mock.calls) != 1 {
@@ -40,11 +42,34 @@ func TestSlackNotifier_Post(t *testing.T) {
 		}
 	})
 
+	t.Run("blocks are sent with text fallback", func(t *testing.T) {
+		mock := &mockAPIPoster{}
+		notifier := &SlackNotifier{poster: mock, channelID: "C12345"}
+
+		blocks := []slack.Block{
+			slack.NewHeaderBlock(slack.NewTextBlockObject(slack.PlainTextType, "header", false, false)),
+		}
+		if err := notifier.Post(context.Background(), formatter.Message{Main: "fallback", Blocks: blocks}); err != nil {
+			t.Fatalf("Post returned error: %v", err)
+		}
+
+		_, values, err := slack.UnsafeApplyMsgOptions("token", "C12345", "https://slack.com/api/", mock.calls[0].options...)
+		if err != nil {
+			t.Fatalf("failed to apply options: %v", err)
+		}
+		if values.Get("text") != "fallback" {
+			t.Errorf("expected text fallback 'fallback', got %q", values.Get("text"))
+		}
+		if !strings.Contains(values.Get("blocks"), `"type":"header"`) {
+			t.Errorf("expected header block in payload, got %q", values.Get("blocks"))
+		}
+	})
+
 	t.Run("parent post failure returns error", func(t *testing.T) {
 		mock := &mockAPIPoster{shouldFail: true}
 		notifier := &SlackNotifier{poster: mock, channelID: "C12345"}
 
-		if err := notifier.Post(formatter.Message{Main: "hello"}); err == nil {
+		if err := notifier.Post(context.Background(), formatter.Message{Main: "hello"}); err == nil {
 			t.Error("expected error when parent post fails")
 		}
 	})
@@ -53,7 +78,7 @@ func TestSlackNotifier_Post(t *testing.T) {
 		mock := &mockAPIPoster{}
 		notifier := &SlackNotifier{poster: mock, channelID: "C12345"}
 
-		err := notifier.Post(formatter.Message{Main: "main text", Sub: "thread text"})
+		err := notifier.Post(context.Background(), formatter.Message{Main: "main text", Sub: "thread text"})
 		if err != nil {
 			t.Fatalf("Post returned error: %v", err)
 		}
@@ -69,7 +94,7 @@ func TestSlackNotifier_Post(t *testing.T) {
 		mock := &flakeyPoster{failAfter: 1}
 		notifier := &SlackNotifier{poster: mock, channelID: "C12345"}
 
-		err := notifier.Post(formatter.Message{Main: "main text", Sub: "thread text"})
+		err := notifier.Post(context.Background(), formatter.Message{Main: "main text", Sub: "thread text"})
 		if err != nil {
 			t.Errorf("Post should not return error when only thread reply fails, got: %v", err)
 		}
@@ -84,7 +109,7 @@ type flakeyPoster struct {
 	callCount int
 }
 
-func (f *flakeyPoster) PostMessage(channelID string, options ...slack.MsgOption) (string, string, error) {
+func (f *flakeyPoster) PostMessageContext(_ context.Context, channelID string, options ...slack.MsgOption) (string, string, error) {
 	f.callCount++
 	if f.callCount > f.failAfter {
 		return "", "", errors.New("mock thread failure")
@@ -0,0 +1,106 @@
+package openreview
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// ContentFields は content の各フィールドの生の値をキーごとに保持します。
+type ContentFields map[string]ValueField[json.RawMessage]
+
+// UnmarshalJSON は {"value": ...} の構造でないフィールドを無視して読み込みます。
+func (f *ContentFields) UnmarshalJSON(data []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	fields := make(ContentFields, len(raw))
+	for key, v := range raw {
+		var field ValueField[json.RawMessage]
+		if json.Unmarshal(v, &field) == nil && len(field.Value) > 0 {
+			fields[key] = field
+		}
+	}
+	*f = fields
+	return nil
+}
+
+// Text は keys のうち最初に見つかったフィールドを文字列として返します。
+// 数値・真偽値は文字列に変換し、文字列の配列はカンマ区切りで連結します。見つからない場合は空文字を返します。
+func (f ContentFields) Text(keys ...string) string {
+	for _, key := range keys {
+		field, ok := f[key]
+		if !ok {
+			continue
+		}
+		var s string
+		if json.Unmarshal(field.Value, &s) == nil {
+			return strings.TrimSpace(s)
+		}
+		var list []string
+		if json.Unmarshal(field.Value, &list) == nil {
+			return strings.Join(list, ", ")
+		}
+		var n json.Number
+		if json.Unmarshal(field.Value, &n) == nil {
+			return n.String()
+		}
+		var b bool
+		if json.Unmarshal(field.Value, &b) == nil {
+			return strconv.FormatBool(b)
+		}
+	}
+	return ""
+}
+
+// List は keys のうち最初に見つかったフィールドを文字列のリストとして返します。
+// 文字列の場合はカンマまたはセミコロンで区切ります。見つからない場合は nil を返します。
+func (f ContentFields) List(keys ...string) []string {
+	for _, key := range keys {
+		field, ok := f[key]
+		if !ok {
+			continue
+		}
+		var list []string
+		if json.Unmarshal(field.Value, &list) == nil {
+			return list
+		}
+		var s string
+		if json.Unmarshal(field.Value, &s) == nil {
+			var items []string
+			for _, item := range strings.FieldsFunc(s, func(r rune) bool { return r == ',' || r == ';' }) {
+				if item = strings.TrimSpace(item); item != "" {
+					items = append(items, item)
+				}
+			}
+			return items
+		}
+	}
+	return nil
+}
+
+// UnmarshalJSON は学会ごとのキー名・値の型の揺れを吸収して content を読み込みます。
+// 存在しないフィールドや型が合わないフィールドはゼロ値になります。
+func (c *NoteContent) UnmarshalJSON(data []byte) error {
+	var fields ContentFields
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+	*c = NoteContent{
+		Title:                 ValueField[string]{Value: fields.Text("title")},
+		Authors:               ValueField[[]string]{Value: fields.List("authors")},
+		AuthorIDs:             ValueField[[]string]{Value: fields.List("authorids")},
+		Abstract:              ValueField[string]{Value: fields.Text("abstract")},
+		TLDR:                  ValueField[string]{Value: fields.Text("TLDR", "TL;DR", "tldr")},
+		Keywords:              ValueField[[]string]{Value: fields.List("keywords")},
+		PrimaryArea:           ValueField[string]{Value: fields.Text("primary_area", "research_area")},
+		PDF:                   ValueField[string]{Value: fields.Text("pdf")},
+		SupplementaryMaterial: ValueField[string]{Value: fields.Text("supplementary_material")},
+		Bibtex:                ValueField[string]{Value: fields.Text("_bibtex")},
+		Venue:                 ValueField[string]{Value: fields.Text("venue")},
+		VenueID:               ValueField[string]{Value: fields.Text("venueid")},
+		Raw:                   fields,
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package openreview
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNoteContent_UnmarshalJSON(t *testing.T) {
+	t.Run("decodes the full schema", func(t *testing.T) {
+		src := `{
+			"title": {"value": " A Paper "},
+			"authors": {"value": ["Alice", "Bob"]},
+			"authorids": {"value": ["~Alice1", "bob@example.com"]},
+			"abstract": {"value": "Abstract."},
+			"TLDR": {"value": "Short summary."},
+			"keywords": {"value": ["reinforcement learning", "robotics"]},
+			"primary_area": {"value": "reinforcement learning"},
+			"pdf": {"value": "/pdf/abc.pdf"},
+			"supplementary_material": {"value": "/attachment/abc.zip"},
+			"_bibtex": {"value": "@inproceedings{...}"},
+			"venue": {"value": "ICLR 2025 Oral"},
+			"venueid": {"value": "ICLR.cc/2025/Conference"},
+			"code_of_ethics": {"value": true},
+			"paperhash": "not a value field"
+		}`
+		var c NoteContent
+		if err := json.Unmarshal([]byte(src), &c); err != nil {
+			t.Fatalf("Unmarshal() failed: %v", err)
+		}
+
+		if c.Title.Value != "A Paper" || c.Abstract.Value != "Abstract." || c.TLDR.Value != "Short summary." {
+			t.Errorf("unexpected text fields: %+v", c)
+		}
+		if !reflect.DeepEqual(c.Authors.Value, []string{"Alice", "Bob"}) || !reflect.DeepEqual(c.AuthorIDs.Value, []string{"~Alice1", "bob@example.com"}) {
+			t.Errorf("unexpected authors: %v %v", c.Authors.Value, c.AuthorIDs.Value)
+		}
+		if !reflect.DeepEqual(c.Keywords.Value, []string{"reinforcement learning", "robotics"}) {
+			t.Errorf("unexpected keywords: %v", c.Keywords.Value)
+		}
+		if c.PrimaryArea.Value != "reinforcement learning" || c.SupplementaryMaterial.Value != "/attachment/abc.zip" || c.Bibtex.Value == "" {
+			t.Errorf("unexpected fields: %+v", c)
+		}
+		if c.Venue.Value != "ICLR 2025 Oral" || c.VenueID.Value != "ICLR.cc/2025/Conference" {
+			t.Errorf("unexpected venue: %q %q", c.Venue.Value, c.VenueID.Value)
+		}
+		if got := c.Raw.Text("code_of_ethics"); got != "true" {
+			t.Errorf("expected other fields to be available in Raw, got %q", got)
+		}
+		if _, ok := c.Raw["paperhash"]; ok {
+			t.Error("expected fields without a value to be skipped")
+		}
+	})
+
+	t.Run("tolerates venue-specific variations", func(t *testing.T) {
+		src := `{
+			"title": {"value": "A Paper"},
+			"TL;DR": {"value": "Older key."},
+			"keywords": {"value": "deep learning; vision, transformers"},
+			"research_area": {"value": "Computer Vision"},
+			"authors": {"value": "Alice"}
+		}`
+		var c NoteContent
+		if err := json.Unmarshal([]byte(src), &c); err != nil {
+			t.Fatalf("Unmarshal() failed: %v", err)
+		}
+		if c.TLDR.Value != "Older key." {
+			t.Errorf("expected TL;DR key to be read, got %q", c.TLDR.Value)
+		}
+		if !reflect.DeepEqual(c.Keywords.Value, []string{"deep learning", "vision", "transformers"}) {
+			t.Errorf("expected keywords string to be split, got %v", c.Keywords.Value)
+		}
+		if c.PrimaryArea.Value != "Computer Vision" {
+			t.Errorf("expected research_area to be read, got %q", c.PrimaryArea.Value)
+		}
+		if !reflect.DeepEqual(c.Authors.Value, []string{"Alice"}) {
+			t.Errorf("unexpected authors: %v", c.Authors.Value)
+		}
+	})
+
+	t.Run("missing fields are zero values", func(t *testing.T) {
+		var c NoteContent
+		if err := json.Unmarshal([]byte(`{"title":{"value":"Only title"},"abstract":{"value":12}}`), &c); err != nil {
+			t.Fatalf("Unmarshal() failed: %v", err)
+		}
+		if c.Keywords.Value != nil || c.TLDR.Value != "" || c.PDF.Value != "" {
+			t.Errorf("expected zero values, got %+v", c)
+		}
+		if c.Abstract.Value != "12" {
+			t.Errorf("expected numeric value as text, got %q", c.Abstract.Value)
+		}
+	})
+}
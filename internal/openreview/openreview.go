@@ -2,21 +2,42 @@ package openreview
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
 	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
+)
+
+const (
+	// DefaultPageSize は1リクエストあたりの取得件数のデフォルト値です (API上限は1000)。
+	DefaultPageSize = 1000
+	// DefaultMaxNotes は GetNotes が取得する論文数の上限のデフォルト値です。
+	DefaultMaxNotes = 20000
 )
 
+var ErrNoteNotFound = errors.New("note not found")
+
 // Client はOpenReview APIと通信するためのクライアントです。
 type Client struct {
 	httpClient *http.Client
 	BaseURL    string
 	UserAgent  string
-	token      string // 追加: 空文字 = 未認証
+	PageSize   int // 1ページあたりの取得件数
+	MaxNotes   int // GetNotes で取得する論文数の上限 (0以下で無制限)
+	Retry      retry.Policy
+	// IncludeReviews を true にすると、論文の取得時にフォーラムの返信も取得し (details=replies)、Note.Reviews を設定します。
+	// 返信を含むためレスポンスが大きくなります。
+	IncludeReviews bool
+	token          string // 追加: 空文字 = 未認証
 }
 
 // NewClient は新しいOpenReviewクライアントを生成します。
@@ -25,6 +46,9 @@ func NewClient(userAgent string) *Client {
 		httpClient: &http.Client{Timeout: 30 * time.Second},
 		BaseURL:    "https://api2.openreview.net",
 		UserAgent:  userAgent,
+		PageSize:   DefaultPageSize,
+		MaxNotes:   DefaultMaxNotes,
+		Retry:      retry.DefaultPolicy(),
 	}
 }
 
@@ -41,16 +65,35 @@ type Note struct {
 	ID      string      `json:"id"`
 	CDate   int64       `json:"cdate"`
 	Content NoteContent `json:"content"`
+
+	Details *NoteDetails `json:"details,omitempty"` // details パラメータを指定した場合のみ含まれる
+
+	Reviews *paper.Reviews `json:"-"` // 査読の集計 (GetReviews または IncludeReviews で取得)。未取得の場合は nil
+}
+
+// NoteDetails は details パラメータで要求した付加情報です。
+type NoteDetails struct {
+	Replies []Reply `json:"replies,omitempty"`
 }
 
 // NoteContent は論文の具体的な内容を保持します。
-// 各フィールドは {"value": ...} という構造になっています。
+// API では各フィールドが {"value": ...} という構造になっています。
+// キー名や値の型は学会によって揺れがあるため、UnmarshalJSON で吸収します (content.go)。
 type NoteContent struct {
-	Title    ValueField[string]   `json:"title"`
-	Authors  ValueField[[]string] `json:"authors"`
-	Abstract ValueField[string]   `json:"abstract"`
-	PDF      ValueField[string]   `json:"pdf,omitempty"`
-	Bibtex   ValueField[string]   `json:"_bibtex,omitempty"`
+	Title                 ValueField[string]
+	Authors               ValueField[[]string]
+	AuthorIDs             ValueField[[]string] // 著者の OpenReview プロフィール ID またはメールアドレス
+	Abstract              ValueField[string]
+	TLDR                  ValueField[string] // "TLDR" / "TL;DR"
+	Keywords              ValueField[[]string]
+	PrimaryArea           ValueField[string] // "primary_area" / "research_area"
+	PDF                   ValueField[string]
+	SupplementaryMaterial ValueField[string] // 補足資料のパス (例: "/attachment/....zip")
+	Bibtex                ValueField[string] // "_bibtex"
+	Venue                 ValueField[string] // 例: "ICLR 2025 Oral"
+	VenueID               ValueField[string] // 採択論文は Venue ID と一致
+
+	Raw ContentFields // 全てのフィールドの生の値。上記以外のフィールドは Raw.Text("code") のように参照する
 }
 
 // ValueField は {"value": T} の構造を表現するためのジェネリックな型です。
@@ -58,46 +101,199 @@ type ValueField[T any] struct {
 	Value T `json:"value"`
 }
 
-// GetNotes は指定されたVenueの論文リストを取得します。
-func (c *Client) GetNotes(venue string) ([]Note, error) {
-	// APIエンドポイントを構築
-	endpoint := fmt.Sprintf("%s/notes?invitation=%s/-/Submission", c.BaseURL, url.QueryEscape(venue))
+// 論文のステータスフィルタです。これら以外の値は採択区分 (oral, spotlight, poster 等) として扱います。
+const (
+	StatusAccepted = "accepted" // 採択論文のみ
+	StatusAll      = "all"      // 不採択・取り下げを含む全投稿
+)
+
+// GetNotes は指定されたVenueの全投稿 (Submission invitation) を取得します。
+// APIResponse.Count に達するまで offset/limit でページを辿り、全件を返します (MaxNotes が上限)。
+func (c *Client) GetNotes(ctx context.Context, venue string) ([]Note, error) {
+	q := url.Values{}
+	q.Set("invitation", venue+"/-/Submission")
+	return c.listNotes(ctx, q)
+}
+
+// GetAcceptedNotes は content.venueid が指定Venueと一致する採択論文を取得します。
+func (c *Client) GetAcceptedNotes(ctx context.Context, venue string) ([]Note, error) {
+	q := url.Values{}
+	q.Set("content.venueid", venue)
+	return c.listNotes(ctx, q)
+}
+
+// GetNotesByStatus はステータスフィルタに応じて論文を取得します。
+// status が空の場合は StatusAccepted として扱います。
+// 採択区分を指定した場合は、採択論文のうち content.venue (例: "ICLR 2025 Oral") に区分名を含むものに絞り込みます。
+func (c *Client) GetNotesByStatus(ctx context.Context, venue, status string) ([]Note, error) {
+	status = strings.ToLower(strings.TrimSpace(status))
+	switch status {
+	case StatusAll:
+		return c.GetNotes(ctx, venue)
+	case "", StatusAccepted:
+		return c.GetAcceptedNotes(ctx, venue)
+	}
+
+	accepted, err := c.GetAcceptedNotes(ctx, venue)
+	if err != nil {
+		return nil, err
+	}
+	var notes []Note
+	for _, n := range accepted {
+		if n.HasDecision(status) {
+			notes = append(notes, n)
+		}
+	}
+	return notes, nil
+}
+
+// GetNote は論文IDを指定して論文を1件取得します。該当する論文がない場合は ErrNoteNotFound を返します。
+func (c *Client) GetNote(ctx context.Context, id string) (*Note, error) {
+	q := url.Values{}
+	q.Set("id", id)
+	page, err := c.getNotesPage(ctx, q, 0, 1)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch note %s: %w", id, err)
+	}
+	if len(page.Notes) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNoteNotFound, id)
+	}
+	return &page.Notes[0], nil
+}
+
+// ParseNoteID は論文IDまたはフォーラム・PDFのURL (例: "https://openreview.net/forum?id=abc123") から論文IDを取り出します。
+func ParseNoteID(s string) string {
+	s = strings.TrimSpace(s)
+	if u, err := url.Parse(s); err == nil && u.Host != "" {
+		if id := u.Query().Get("id"); id != "" {
+			return id
+		}
+	}
+	return s
+}
+
+// CountNotes はステータスフィルタに該当する論文の件数を返します。論文本体は取得せず、APIResponse.Count のみを参照します。
+// 採択区分 (oral など) を指定した場合は区分で絞り込めないため、採択論文全体の件数を返します。
+func (c *Client) CountNotes(ctx context.Context, venue, status string) (int, error) {
+	q := url.Values{}
+	if strings.ToLower(strings.TrimSpace(status)) == StatusAll {
+		q.Set("invitation", venue+"/-/Submission")
+	} else {
+		q.Set("content.venueid", venue)
+	}
+
+	page, err := c.getNotesPage(ctx, q, 0, 1)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count notes: %w", err)
+	}
+	return page.Count, nil
+}
+
+// listNotes は /notes エンドポイントを offset/limit でページングし、条件に一致する論文を全件取得します。
+func (c *Client) listNotes(ctx context.Context, query url.Values) ([]Note, error) {
+	pageSize := c.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	var notes []Note
+	for offset := 0; ; offset += pageSize {
+		limit := pageSize
+		if c.MaxNotes > 0 && offset+limit > c.MaxNotes {
+			limit = c.MaxNotes - offset
+		}
+
+		page, err := c.getNotesPage(ctx, query, offset, limit)
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch notes (offset=%d): %w", offset, err)
+		}
+		notes = append(notes, page.Notes...)
+
+		if len(page.Notes) == 0 || len(page.Notes) < limit {
+			break // 最終ページ
+		}
+		if page.Count > 0 && len(notes) >= page.Count {
+			break // 全件取得済み
+		}
+		if c.MaxNotes > 0 && len(notes) >= c.MaxNotes {
+			break // 上限到達
+		}
+	}
+
+	return notes, nil
+}
+
+// getNotesPage は /notes エンドポイントから1ページ分の論文を取得します。
+func (c *Client) getNotesPage(ctx context.Context, query url.Values, offset, limit int) (*APIResponse, error) {
+	if c.IncludeReviews {
+		query = withParam(query, "details", "replies")
+	}
+	var apiResponse APIResponse
+	if err := c.getNotesInto(ctx, query, offset, limit, &apiResponse); err != nil {
+		return nil, err
+	}
+	if c.IncludeReviews {
+		for i := range apiResponse.Notes {
+			if d := apiResponse.Notes[i].Details; d != nil {
+				apiResponse.Notes[i].Reviews = summarizeReviews(d.Replies)
+				apiResponse.Notes[i].Details = nil // 集計済みの返信は保持しない
+			}
+		}
+	}
+	return &apiResponse, nil
+}
 
-	req, err := http.NewRequest(http.MethodGet, endpoint, nil)
+// withParam は query を複製してパラメータを追加します。
+func withParam(query url.Values, key, value string) url.Values {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set(key, value)
+	return q
+}
+
+// getNotesInto は /notes エンドポイントから1ページ分を取得し、レスポンスを out にデコードします。
+func (c *Client) getNotesInto(ctx context.Context, query url.Values, offset, limit int, out any) error {
+	q := url.Values{}
+	for k, v := range query {
+		q[k] = v
+	}
+	q.Set("offset", strconv.Itoa(offset))
+	q.Set("limit", strconv.Itoa(limit))
+	endpoint := c.BaseURL + "/notes?" + q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return fmt.Errorf("failed to create request: %w", err)
 	}
 	req.Header.Set("User-Agent", c.UserAgent)
 	if c.token != "" {
 		req.Header.Set("Authorization", "Bearer "+c.token)
 	}
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.Retry.Do(c.httpClient, req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+		return fmt.Errorf("failed to execute request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+		return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 	}
 
-	var apiResponse APIResponse
-	if err := json.NewDecoder(resp.Body).Decode(&apiResponse); err != nil {
-		return nil, fmt.Errorf("failed to decode response body: %w", err)
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode response body: %w", err)
 	}
-
-	return apiResponse.Notes, nil
-}
-
-// GetID はPaperインターフェースを満たすためにNoteのIDを返します。
-func (n *Note) GetID() string {
-	return n.ID
+	return nil
 }
 
-// GetTitle はPaperインターフェースを満たすためにNoteのタイトルを返します。
-func (n *Note) GetTitle() string {
-	return n.Content.Title.Value
+// HasDecision は content.venue (例: "ICLR 2025 Oral") に採択区分名が含まれるかを大文字小文字を区別せずに判定します。
+func (n *Note) HasDecision(decision string) bool {
+	if decision == "" {
+		return false
+	}
+	return strings.Contains(strings.ToLower(n.Content.Venue.Value), strings.ToLower(decision))
 }
 
 // loginRequest は /login エンドポイントへのリクエストボディです。
@@ -112,20 +308,21 @@ type loginResponse struct {
 }
 
 // Login は OpenReview API で認証し、取得したトークンをクライアントに保存します。
-func (c *Client) Login(email, password string) error {
+func (c *Client) Login(ctx context.Context, email, password string) error {
 	payload, err := json.Marshal(loginRequest{ID: email, Password: password})
 	if err != nil {
 		return fmt.Errorf("failed to marshal login request: %w", err)
 	}
 
-	req, err := http.NewRequest(http.MethodPost, c.BaseURL+"/login", bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.BaseURL+"/login", bytes.NewReader(payload))
 	if err != nil {
 		return fmt.Errorf("failed to create login request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("User-Agent", c.UserAgent)
+	retry.MarkIdempotent(req) // ログインは何度送っても副作用がない
 
-	resp, err := c.httpClient.Do(req)
+	resp, err := c.Retry.Do(c.httpClient, req)
 	if err != nil {
 		return fmt.Errorf("failed to execute login request: %w", err)
 	}
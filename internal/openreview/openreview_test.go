@@ -1,11 +1,19 @@
 package openreview
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
 )
 
 // TestGetNotes_Integration は、実際のOpenReview APIにアクセスしてデータを取得する統合テストです。
@@ -22,7 +30,7 @@ func TestGetNotes_Integration(t *testing.T) {
 
 		// ICLR 2024 のような、確実にデータが存在する過去のカンファレンスを対象とする
 		venue := "ICLR.cc/2024/Conference"
-		notes, err := client.GetNotes(venue)
+		notes, err := client.GetNotes(context.Background(), venue)
 
 		if err != nil {
 			t.Fatalf("expected no error, but got: %v", err)
@@ -62,7 +70,7 @@ func TestLogin_Success(t *testing.T) {
 	client := NewClient("test-agent")
 	client.BaseURL = server.URL
 
-	err := client.Login("user@example.com", "password")
+	err := client.Login(context.Background(), "user@example.com", "password")
 	if err != nil {
 		t.Fatalf("expected no error, but got: %v", err)
 	}
@@ -80,7 +88,7 @@ func TestLogin_Failure_NonOKStatus(t *testing.T) {
 	client := NewClient("test-agent")
 	client.BaseURL = server.URL
 
-	err := client.Login("user@example.com", "wrong-password")
+	err := client.Login(context.Background(), "user@example.com", "wrong-password")
 	if err == nil {
 		t.Fatal("expected an error, but got nil")
 	}
@@ -97,7 +105,7 @@ func TestLogin_Failure_EmptyToken(t *testing.T) {
 	client := NewClient("test-agent")
 	client.BaseURL = server.URL
 
-	err := client.Login("user@example.com", "password")
+	err := client.Login(context.Background(), "user@example.com", "password")
 	if err == nil {
 		t.Fatal("expected an error for empty token, but got nil")
 	}
@@ -117,7 +125,7 @@ func TestGetNotes_WithAuthToken(t *testing.T) {
 	client.BaseURL = server.URL
 	client.token = "test-jwt-token"
 
-	_, err := client.GetNotes("TestVenue/Conference")
+	_, err := client.GetNotes(context.Background(), "TestVenue/Conference")
 	if err != nil {
 		t.Fatalf("expected no error, but got: %v", err)
 	}
@@ -140,7 +148,7 @@ func TestGetNotes_WithoutAuthToken(t *testing.T) {
 	client.BaseURL = server.URL
 	// token は空のまま（デフォルト）
 
-	_, err := client.GetNotes("TestVenue/Conference")
+	_, err := client.GetNotes(context.Background(), "TestVenue/Conference")
 	if err != nil {
 		t.Fatalf("expected no error, but got: %v", err)
 	}
@@ -148,3 +156,320 @@ func TestGetNotes_WithoutAuthToken(t *testing.T) {
 		t.Errorf("expected no Authorization header, got '%s'", capturedAuthHeader)
 	}
 }
+
+// newPagedServer は total 件の論文を offset/limit に従ってページングして返すテスト用サーバーです。
+func newPagedServer(t *testing.T, total int, requests *[]string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r.URL.RawQuery)
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+		var notes []string
+		for i := offset; i < offset+limit && i < total; i++ {
+			notes = append(notes, fmt.Sprintf(`{"id":"n%d","content":{"title":{"value":"T%d"}}}`, i, i))
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"notes":[%s],"count":%d}`, strings.Join(notes, ","), total)
+	}))
+}
+
+func TestGetNotes_Pagination(t *testing.T) {
+	t.Run("walks pages until count is reached", func(t *testing.T) {
+		var requests []string
+		server := newPagedServer(t, 25, &requests)
+		defer server.Close()
+
+		client := NewClient("test-agent")
+		client.BaseURL = server.URL
+		client.PageSize = 10
+
+		notes, err := client.GetNotes(context.Background(), "TestVenue/Conference")
+		if err != nil {
+			t.Fatalf("expected no error, but got: %v", err)
+		}
+		if len(notes) != 25 {
+			t.Fatalf("expected 25 notes, got %d", len(notes))
+		}
+		if notes[0].ID != "n0" || notes[24].ID != "n24" {
+			t.Errorf("unexpected note order: first=%s last=%s", notes[0].ID, notes[24].ID)
+		}
+		if len(requests) != 3 {
+			t.Errorf("expected 3 requests, got %d: %v", len(requests), requests)
+		}
+		if !strings.Contains(requests[0], "invitation=TestVenue%2FConference%2F-%2FSubmission") {
+			t.Errorf("expected invitation query, got %q", requests[0])
+		}
+	})
+
+	t.Run("exact multiple of page size stops at count", func(t *testing.T) {
+		var requests []string
+		server := newPagedServer(t, 20, &requests)
+		defer server.Close()
+
+		client := NewClient("test-agent")
+		client.BaseURL = server.URL
+		client.PageSize = 10
+
+		notes, err := client.GetNotes(context.Background(), "TestVenue/Conference")
+		if err != nil {
+			t.Fatalf("expected no error, but got: %v", err)
+		}
+		if len(notes) != 20 {
+			t.Errorf("expected 20 notes, got %d", len(notes))
+		}
+		if len(requests) != 2 {
+			t.Errorf("expected 2 requests, got %d: %v", len(requests), requests)
+		}
+	})
+
+	t.Run("stops at MaxNotes", func(t *testing.T) {
+		var requests []string
+		server := newPagedServer(t, 100, &requests)
+		defer server.Close()
+
+		client := NewClient("test-agent")
+		client.BaseURL = server.URL
+		client.PageSize = 10
+		client.MaxNotes = 25
+
+		notes, err := client.GetNotes(context.Background(), "TestVenue/Conference")
+		if err != nil {
+			t.Fatalf("expected no error, but got: %v", err)
+		}
+		if len(notes) != 25 {
+			t.Errorf("expected 25 notes, got %d", len(notes))
+		}
+		if !strings.Contains(requests[len(requests)-1], "limit=5") {
+			t.Errorf("expected last request to ask for the remaining 5 notes, got %q", requests[len(requests)-1])
+		}
+	})
+
+	t.Run("error on later page fails whole fetch", func(t *testing.T) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls > 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			fmt.Fprint(w, `{"notes":[{"id":"a"},{"id":"b"}],"count":4}`)
+		}))
+		defer server.Close()
+
+		client := NewClient("test-agent")
+		client.BaseURL = server.URL
+		client.PageSize = 2
+		client.Retry = retry.NoRetry()
+
+		if _, err := client.GetNotes(context.Background(), "TestVenue/Conference"); err == nil {
+			t.Fatal("expected an error, but got nil")
+		}
+	})
+}
+
+func TestCountNotes(t *testing.T) {
+	var requests []string
+	server := newPagedServer(t, 42, &requests)
+	defer server.Close()
+
+	client := NewClient("test-agent")
+	client.BaseURL = server.URL
+
+	t.Run("accepted counts by content.venueid with a single note", func(t *testing.T) {
+		count, err := client.CountNotes(context.Background(), "ICLR.cc/2025/Conference", StatusAccepted)
+		if err != nil {
+			t.Fatalf("expected no error, but got: %v", err)
+		}
+		if count != 42 {
+			t.Errorf("expected 42, got %d", count)
+		}
+		last := requests[len(requests)-1]
+		if !strings.Contains(last, "content.venueid=ICLR.cc%2F2025%2FConference") || !strings.Contains(last, "limit=1") {
+			t.Errorf("unexpected query: %q", last)
+		}
+	})
+
+	t.Run("all counts by Submission invitation", func(t *testing.T) {
+		if _, err := client.CountNotes(context.Background(), "ICLR.cc/2025/Conference", StatusAll); err != nil {
+			t.Fatalf("expected no error, but got: %v", err)
+		}
+		if last := requests[len(requests)-1]; !strings.Contains(last, "invitation=ICLR.cc%2F2025%2FConference%2F-%2FSubmission") {
+			t.Errorf("unexpected query: %q", last)
+		}
+	})
+}
+
+func TestGetNotesByStatus(t *testing.T) {
+	var capturedQuery url.Values
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		capturedQuery = r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"notes":[
+			{"id":"o1","content":{"venue":{"value":"ICLR 2025 Oral"}}},
+			{"id":"s1","content":{"venue":{"value":"ICLR 2025 Spotlight"}}},
+			{"id":"p1","content":{"venue":{"value":"ICLR 2025 Poster"}}}
+		],"count":3}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-agent")
+	client.BaseURL = server.URL
+
+	t.Run("accepted queries by content.venueid", func(t *testing.T) {
+		notes, err := client.GetNotesByStatus(context.Background(), "ICLR.cc/2025/Conference", StatusAccepted)
+		if err != nil {
+			t.Fatalf("expected no error, but got: %v", err)
+		}
+		if got := capturedQuery.Get("content.venueid"); got != "ICLR.cc/2025/Conference" {
+			t.Errorf("expected content.venueid query, got %q", got)
+		}
+		if capturedQuery.Get("invitation") != "" {
+			t.Errorf("expected no invitation query, got %q", capturedQuery.Get("invitation"))
+		}
+		if len(notes) != 3 {
+			t.Errorf("expected 3 notes, got %d", len(notes))
+		}
+	})
+
+	t.Run("empty status defaults to accepted", func(t *testing.T) {
+		if _, err := client.GetNotesByStatus(context.Background(), "ICLR.cc/2025/Conference", ""); err != nil {
+			t.Fatalf("expected no error, but got: %v", err)
+		}
+		if capturedQuery.Get("content.venueid") == "" {
+			t.Error("expected content.venueid query for empty status")
+		}
+	})
+
+	t.Run("all queries by Submission invitation", func(t *testing.T) {
+		if _, err := client.GetNotesByStatus(context.Background(), "ICLR.cc/2025/Conference", StatusAll); err != nil {
+			t.Fatalf("expected no error, but got: %v", err)
+		}
+		if got := capturedQuery.Get("invitation"); got != "ICLR.cc/2025/Conference/-/Submission" {
+			t.Errorf("expected Submission invitation query, got %q", got)
+		}
+	})
+
+	t.Run("decision filters by content.venue", func(t *testing.T) {
+		notes, err := client.GetNotesByStatus(context.Background(), "ICLR.cc/2025/Conference", "Oral")
+		if err != nil {
+			t.Fatalf("expected no error, but got: %v", err)
+		}
+		if len(notes) != 1 || notes[0].ID != "o1" {
+			t.Errorf("expected only o1, got %+v", notes)
+		}
+	})
+}
+
+func TestGetNotes_RetriesTransientFailures(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls <= 2 {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"notes":[{"id":"a"}],"count":1}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-agent")
+	client.BaseURL = server.URL
+	client.Retry = retry.Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second}
+
+	notes, err := client.GetNotes(context.Background(), "TestVenue/Conference")
+	if err != nil {
+		t.Fatalf("expected no error after retries, but got: %v", err)
+	}
+	if len(notes) != 1 {
+		t.Errorf("expected 1 note, got %d", len(notes))
+	}
+	if calls != 3 {
+		t.Errorf("expected 3 calls, got %d", calls)
+	}
+}
+
+func TestGetNotes_ContextCanceled(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-agent")
+	client.BaseURL = server.URL
+	client.Retry = retry.Policy{MaxAttempts: 5, BaseDelay: time.Hour, MaxDelay: time.Hour}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.GetNotes(ctx, "TestVenue/Conference")
+	if err == nil {
+		t.Fatal("expected an error, but got nil")
+	}
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("expected cancellation to interrupt backoff, took %v", elapsed)
+	}
+	if calls != 1 {
+		t.Errorf("expected 1 call before cancellation, got %d", calls)
+	}
+}
+
+func TestGetNote(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("limit"); got != "1" {
+			t.Errorf("expected limit=1, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Query().Get("id") == "abc123" {
+			fmt.Fprintln(w, `{"notes":[{"id":"abc123","content":{"title":{"value":"Found"},"venueid":{"value":"ICLR.cc/2025/Conference"}}}],"count":1}`)
+			return
+		}
+		fmt.Fprintln(w, `{"notes":[],"count":0}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-agent")
+	client.BaseURL = server.URL
+
+	t.Run("returns the note with the id", func(t *testing.T) {
+		note, err := client.GetNote(context.Background(), "abc123")
+		if err != nil {
+			t.Fatalf("expected no error, but got: %v", err)
+		}
+		if note.ID != "abc123" || note.Content.Title.Value != "Found" {
+			t.Errorf("unexpected note: %+v", note)
+		}
+		if note.Content.VenueID.Value != "ICLR.cc/2025/Conference" {
+			t.Errorf("unexpected venueid: %q", note.Content.VenueID.Value)
+		}
+	})
+
+	t.Run("unknown id returns ErrNoteNotFound", func(t *testing.T) {
+		_, err := client.GetNote(context.Background(), "missing")
+		if !errors.Is(err, ErrNoteNotFound) {
+			t.Errorf("expected ErrNoteNotFound, got %v", err)
+		}
+	})
+}
+
+func TestParseNoteID(t *testing.T) {
+	tests := map[string]string{
+		"abc123":                                 "abc123",
+		" abc123 ":                               "abc123",
+		"https://openreview.net/forum?id=abc123": "abc123",
+		"https://openreview.net/pdf?id=abc123":   "abc123",
+		"https://openreview.net/forum?id=abc123&noteId=xyz": "abc123",
+	}
+	for in, want := range tests {
+		if got := ParseNoteID(in); got != want {
+			t.Errorf("ParseNoteID(%q): expected %q, got %q", in, want, got)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package openreview
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+)
+
+// 査読スコアとして読み取る content のキーです。学会によって名前が異なるため、先頭から順に探します。
+var (
+	ratingKeys     = []string{"rating", "recommendation", "overall_recommendation", "overall_assessment"}
+	confidenceKeys = []string{"confidence"}
+)
+
+// leadingNumberPattern は "8: accept, good paper" のような選択肢形式のスコアから先頭の数値を取り出します。
+var leadingNumberPattern = regexp.MustCompile(`^\s*(-?\d+(?:\.\d+)?)`)
+
+// Reply はフォーラム内の返信 (査読・メタレビュー・採否など) です。
+// content の構造は返信の種類や学会によって異なるため、値は生の JSON のまま保持します。
+type Reply struct {
+	ID          string        `json:"id"`
+	Invitations []string      `json:"invitations"`
+	Content     ContentFields `json:"content"`
+}
+
+// replyResponse は forum を指定した /notes エンドポイントのレスポンスです。
+type replyResponse struct {
+	Notes []Reply `json:"notes"`
+	Count int     `json:"count"`
+}
+
+// GetReviews はフォーラム (論文ID) の返信を取得し、査読スコアと採否を集計します。
+// 査読が非公開の場合は空の paper.Reviews を返します。
+func (c *Client) GetReviews(ctx context.Context, forumID string) (*paper.Reviews, error) {
+	pageSize := c.PageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	q := url.Values{}
+	q.Set("forum", forumID)
+	var page replyResponse
+	if err := c.getNotesInto(ctx, q, 0, pageSize, &page); err != nil {
+		return nil, fmt.Errorf("failed to fetch replies of %s: %w", forumID, err)
+	}
+	return summarizeReviews(page.Notes), nil
+}
+
+// summarizeReviews は返信を種類ごとに振り分けて集計します。
+func summarizeReviews(replies []Reply) *paper.Reviews {
+	reviews := &paper.Reviews{}
+	var recommendation string
+	for _, r := range replies {
+		switch {
+		case r.hasInvitation("Decision"):
+			if d := r.Content.Text("decision"); d != "" {
+				reviews.Decision = d
+			}
+		case r.hasInvitation("Meta_Review"):
+			recommendation = r.Content.Text("recommendation")
+		case r.hasInvitation("Official_Review"), r.hasInvitation("Review"):
+			reviews.NumReviews++
+			if v, ok := r.score(ratingKeys); ok {
+				reviews.Ratings = append(reviews.Ratings, v)
+			}
+			if v, ok := r.score(confidenceKeys); ok {
+				reviews.Confidences = append(reviews.Confidences, v)
+			}
+		}
+	}
+	if reviews.Decision == "" {
+		reviews.Decision = recommendation
+	}
+	return reviews
+}
+
+// hasInvitation は返信が "<venue>/.../-/<name>" の invitation で作成されたかを判定します。
+func (r Reply) hasInvitation(name string) bool {
+	for _, inv := range r.Invitations {
+		if strings.HasSuffix(inv, "/-/"+name) {
+			return true
+		}
+	}
+	return false
+}
+
+// score は keys のうち最初に見つかった content の値を数値として返します。
+// 数値のほか、"8: accept, good paper" のような先頭が数値の文字列にも対応します。
+func (r Reply) score(keys []string) (float64, bool) {
+	for _, key := range keys {
+		f, ok := r.Content[key]
+		if !ok {
+			continue
+		}
+		var v float64
+		if json.Unmarshal(f.Value, &v) == nil {
+			return v, true
+		}
+		if m := leadingNumberPattern.FindStringSubmatch(r.Content.Text(key)); m != nil {
+			if v, err := strconv.ParseFloat(m[1], 64); err == nil {
+				return v, true
+			}
+		}
+	}
+	return 0, false
+}
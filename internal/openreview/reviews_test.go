@@ -0,0 +1,113 @@
+package openreview
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const forumRepliesJSON = `{"notes":[
+	{"id":"PID","invitations":["ICLR.cc/2025/Conference/-/Submission"],"content":{"title":{"value":"Paper"}}},
+	{"id":"r1","invitations":["ICLR.cc/2025/Conference/Submission1/-/Official_Review"],"content":{"rating":{"value":8},"confidence":{"value":4}}},
+	{"id":"r2","invitations":["ICLR.cc/2025/Conference/Submission1/-/Official_Review"],"content":{"rating":{"value":"6: marginally above the acceptance threshold"},"confidence":{"value":"3: You are fairly confident"}}},
+	{"id":"r3","invitations":["ICLR.cc/2025/Conference/Submission1/-/Official_Review"],"content":{"summary":{"value":"no score"}}},
+	{"id":"m1","invitations":["ICLR.cc/2025/Conference/Submission1/-/Meta_Review"],"content":{"recommendation":{"value":"Accept (Poster)"}}},
+	{"id":"d1","invitations":["ICLR.cc/2025/Conference/Submission1/-/Decision"],"content":{"decision":{"value":"Accept (Oral)"}}},
+	{"id":"c1","invitations":["ICLR.cc/2025/Conference/Submission1/-/Official_Comment"],"content":{"rating":{"value":1}}}
+],"count":7}`
+
+func TestGetReviews(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("forum"); got != "PID" {
+			t.Errorf("expected forum=PID, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, forumRepliesJSON)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-agent")
+	client.BaseURL = server.URL
+
+	reviews, err := client.GetReviews(context.Background(), "PID")
+	if err != nil {
+		t.Fatalf("expected no error, but got: %v", err)
+	}
+	if reviews.Decision != "Accept (Oral)" {
+		t.Errorf("expected decision from the Decision reply, got %q", reviews.Decision)
+	}
+	if reviews.NumReviews != 3 {
+		t.Errorf("expected 3 reviews, got %d", reviews.NumReviews)
+	}
+	if len(reviews.Ratings) != 2 || reviews.MeanRating() != 7 {
+		t.Errorf("expected ratings [8 6] (mean 7), got %v", reviews.Ratings)
+	}
+	if reviews.MeanConfidence() != 3.5 {
+		t.Errorf("expected mean confidence 3.5, got %v", reviews.MeanConfidence())
+	}
+}
+
+func TestSummarizeReviews(t *testing.T) {
+	t.Run("meta review recommendation is used without a decision", func(t *testing.T) {
+		var replies []Reply
+		src := `[{"invitations":["TMLR/Paper1/-/Meta_Review"],"content":{"recommendation":{"value":"Accept as is"}}}]`
+		if err := json.Unmarshal([]byte(src), &replies); err != nil {
+			t.Fatalf("failed to parse fixture: %v", err)
+		}
+		if got := summarizeReviews(replies).Decision; got != "Accept as is" {
+			t.Errorf("expected decision from the meta review, got %q", got)
+		}
+	})
+
+	t.Run("no replies is empty", func(t *testing.T) {
+		reviews := summarizeReviews(nil)
+		if !reviews.IsEmpty() || reviews.MeanRating() != 0 {
+			t.Errorf("expected empty reviews, got %+v", reviews)
+		}
+	})
+}
+
+func TestGetNotes_IncludeReviews(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.URL.Query().Get("details"); got != "replies" {
+			t.Errorf("expected details=replies, got %q", got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"notes":[
+			{"id":"p1","content":{"title":{"value":"Reviewed"},"venue":{"value":"ICLR 2025 Spotlight"}},"details":{"replies":[
+				{"id":"r1","invitations":["ICLR.cc/2025/Conference/Submission1/-/Official_Review"],"content":{"rating":{"value":6}}},
+				{"id":"r2","invitations":["ICLR.cc/2025/Conference/Submission1/-/Official_Review"],"content":{"rating":{"value":8}}}
+			]}},
+			{"id":"p2","content":{"title":{"value":"Not reviewed"},"venue":{"value":"ICLR 2025 Poster"}}}
+		],"count":2}`)
+	}))
+	defer server.Close()
+
+	client := NewClient("test-agent")
+	client.BaseURL = server.URL
+	client.IncludeReviews = true
+
+	notes, err := client.GetAcceptedNotes(context.Background(), "ICLR.cc/2025/Conference")
+	if err != nil {
+		t.Fatalf("expected no error, but got: %v", err)
+	}
+	if len(notes) != 2 {
+		t.Fatalf("expected 2 notes, got %d", len(notes))
+	}
+
+	if rating, ok := notes[0].ToPaper().GetMeanRating(); !ok || rating != 7 {
+		t.Errorf("expected mean rating 7, got %v (ok=%t)", rating, ok)
+	}
+	if notes[0].Details != nil {
+		t.Error("expected replies to be dropped after summarizing")
+	}
+	if _, ok := notes[1].ToPaper().GetMeanRating(); ok {
+		t.Error("expected no rating for a note without replies")
+	}
+	if got := notes[1].ToPaper().GetDecision(); got != "ICLR 2025 Poster" {
+		t.Errorf("expected decision to fall back to content.venue, got %q", got)
+	}
+}
@@ -0,0 +1,76 @@
+package openreview
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+)
+
+// WebURL は OpenReview の Web サイトの URL です。フォーラムページや PDF のリンクに使います。
+const WebURL = "https://openreview.net"
+
+// ListPapers は学会設定の Venue と Status に該当する論文を取得します (source.Source の実装)。
+func (c *Client) ListPapers(ctx context.Context, venue config.VenueConfig) ([]*paper.Paper, error) {
+	notes, err := c.GetNotesByStatus(ctx, venue.Venue, venue.Status)
+	if err != nil {
+		return nil, err
+	}
+	papers := make([]*paper.Paper, len(notes))
+	for i := range notes {
+		papers[i] = notes[i].ToPaper()
+	}
+	return papers, nil
+}
+
+// CountPapers は学会設定の Venue と Status に該当する論文の件数を返します (source.Source の実装)。
+func (c *Client) CountPapers(ctx context.Context, venue config.VenueConfig) (int, error) {
+	return c.CountNotes(ctx, venue.Venue, venue.Status)
+}
+
+// GetPaper は論文IDを指定して論文を1件取得します (source.Source の実装)。
+func (c *Client) GetPaper(ctx context.Context, id string) (*paper.Paper, error) {
+	note, err := c.GetNote(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return note.ToPaper(), nil
+}
+
+// ToPaper は Note を取得元によらない paper.Paper に変換します。
+// Metadata には content の全てのフィールドをテキストにして格納します (例: "_bibtex", "code")。
+func (n *Note) ToPaper() *paper.Paper {
+	p := &paper.Paper{
+		ID:          n.ID,
+		Source:      config.SourceOpenReview,
+		Title:       n.Content.Title.Value,
+		Authors:     n.Content.Authors.Value,
+		Abstract:    n.Content.Abstract.Value,
+		TLDR:        n.Content.TLDR.Value,
+		Keywords:    n.Content.Keywords.Value,
+		PrimaryArea: n.Content.PrimaryArea.Value,
+		URL:         WebURL + "/forum?id=" + n.ID,
+		PDFURL:      absoluteURL(n.Content.PDF.Value),
+		Venue:       n.Content.Venue.Value,
+		VenueID:     n.Content.VenueID.Value,
+		Reviews:     n.Reviews,
+		Metadata:    make(map[string]string, len(n.Content.Raw)),
+	}
+	if n.CDate > 0 {
+		p.Published = time.UnixMilli(n.CDate)
+	}
+	for key := range n.Content.Raw {
+		p.Metadata[key] = n.Content.Raw.Text(key)
+	}
+	return p
+}
+
+// absoluteURL は content の相対パス (例: "/pdf/abc.pdf") を絶対URLに変換します。空の場合は空文字を返します。
+func absoluteURL(path string) string {
+	if path == "" || strings.HasPrefix(path, "http") {
+		return path
+	}
+	return WebURL + path
+}
@@ -0,0 +1,51 @@
+package openreview
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+)
+
+func TestNote_ToPaper(t *testing.T) {
+	var note Note
+	err := json.Unmarshal([]byte(`{"id":"abc","cdate":1704067200000,"content":{
+		"title":{"value":"T"},"authors":{"value":["A","B"]},"abstract":{"value":"Abs"},
+		"TLDR":{"value":"short"},"keywords":{"value":["k1","k2"]},"primary_area":{"value":"area"},
+		"pdf":{"value":"/pdf/abc.pdf"},"venue":{"value":"ICLR 2025 Oral"},"venueid":{"value":"ICLR.cc/2025/Conference"},
+		"code":{"value":"https://github.com/x/y"}}}`), &note)
+	if err != nil {
+		t.Fatalf("failed to unmarshal note: %v", err)
+	}
+
+	p := note.ToPaper()
+	if p.ID != "abc" || p.Source != config.SourceOpenReview || p.Title != "T" || p.Abstract != "Abs" || p.TLDR != "short" || p.PrimaryArea != "area" {
+		t.Errorf("unexpected paper: %+v", p)
+	}
+	if len(p.Authors) != 2 || len(p.Keywords) != 2 {
+		t.Errorf("unexpected authors/keywords: %v / %v", p.Authors, p.Keywords)
+	}
+	if p.URL != "https://openreview.net/forum?id=abc" || p.PDFURL != "https://openreview.net/pdf/abc.pdf" {
+		t.Errorf("unexpected links: %q / %q", p.URL, p.PDFURL)
+	}
+	if p.Venue != "ICLR 2025 Oral" || p.VenueID != "ICLR.cc/2025/Conference" {
+		t.Errorf("unexpected venue: %q / %q", p.Venue, p.VenueID)
+	}
+	if !p.Published.Equal(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected published date: %v", p.Published)
+	}
+	if p.Metadata["code"] != "https://github.com/x/y" {
+		t.Errorf("expected raw content field in metadata, got %v", p.Metadata)
+	}
+}
+
+func TestNote_ToPaper_AbsolutePDF(t *testing.T) {
+	note := Note{ID: "abc", Content: NoteContent{PDF: ValueField[string]{Value: "https://example.com/a.pdf"}}}
+	if got := note.ToPaper().PDFURL; got != "https://example.com/a.pdf" {
+		t.Errorf("expected absolute pdf url to be kept, got %q", got)
+	}
+	if got := (&Note{ID: "abc"}).ToPaper(); got.PDFURL != "" || !got.Published.IsZero() {
+		t.Errorf("expected no pdf and zero published date, got %+v", got)
+	}
+}
@@ -0,0 +1,92 @@
+// Package paper は取得元 (OpenReview, arXiv など) によらない論文のデータモデルを定義します。
+// 各取得元のクライアントは取得した論文を Paper に変換し、選定・整形・翻訳・投稿は Paper のみを扱います。
+package paper
+
+import (
+	"maps"
+	"slices"
+	"time"
+)
+
+// Paper は論文一件の情報です。
+type Paper struct {
+	ID     string // 取得元での論文ID (例: OpenReview の note ID, arXiv ID)
+	Source string // 取得元 (config.SourceOpenReview / config.SourceArXiv)
+
+	Title       string
+	Authors     []string
+	Abstract    string
+	TLDR        string   // ない場合は空文字
+	Keywords    []string // キーワード (arXiv の場合はカテゴリ)
+	PrimaryArea string   // 主分野 (arXiv の場合は主カテゴリ)。ない場合は空文字
+
+	URL    string // 論文ページ (OpenReview のフォーラムページ、arXiv の abs ページなど)
+	PDFURL string // PDF の絶対URL。ない場合は空文字
+
+	Venue     string    // 掲載先の表示名 (例: "ICLR 2025 Oral", "arXiv")
+	VenueID   string    // 掲載先の ID (例: OpenReview の content.venueid)。ない場合は空文字
+	Published time.Time // 投稿日時。不明な場合はゼロ値
+
+	Reviews  *Reviews          // 採否と査読スコア。未取得、または査読のない取得元の場合は nil
+	Metadata map[string]string // 取得元固有の情報 (例: "bibtex", "journal_ref")。値はテキストに変換済み
+}
+
+// MetadataKeys は Metadata のキーを昇順で返します。
+func (p *Paper) MetadataKeys() []string {
+	return slices.Sorted(maps.Keys(p.Metadata))
+}
+
+// GetID は selector.Paper インターフェースを満たすために論文IDを返します。
+func (p *Paper) GetID() string {
+	return p.ID
+}
+
+// GetTitle は selector.Paper インターフェースを満たすためにタイトルを返します。
+func (p *Paper) GetTitle() string {
+	return p.Title
+}
+
+// GetAbstract は selector.Described インターフェースを満たすために Abstract を返します。
+func (p *Paper) GetAbstract() string {
+	return p.Abstract
+}
+
+// GetKeywords は selector.Described インターフェースを満たすためにキーワードを返します。
+func (p *Paper) GetKeywords() []string {
+	return p.Keywords
+}
+
+// GetTLDR は selector.Described インターフェースを満たすために TL;DR を返します。
+func (p *Paper) GetTLDR() string {
+	return p.TLDR
+}
+
+// GetPrimaryArea は selector.Described インターフェースを満たすために主分野を返します。
+func (p *Paper) GetPrimaryArea() string {
+	return p.PrimaryArea
+}
+
+// GetCDate は selector.Dated インターフェースを満たすために投稿日時 (Unix ミリ秒) を返します。不明な場合は 0 を返します。
+func (p *Paper) GetCDate() int64 {
+	if p.Published.IsZero() {
+		return 0
+	}
+	return p.Published.UnixMilli()
+}
+
+// GetMeanRating は selector.Reviewed インターフェースを満たすために平均評価スコアを返します。
+func (p *Paper) GetMeanRating() (float64, bool) {
+	if p.Reviews == nil || len(p.Reviews.Ratings) == 0 {
+		return 0, false
+	}
+	return p.Reviews.MeanRating(), true
+}
+
+// GetDecision は selector.Reviewed インターフェースを満たすために採否を返します。
+// 査読結果に採否がない場合は掲載先の表示名 (例: "ICLR 2025 Oral") を返します。
+func (p *Paper) GetDecision() string {
+	if p.Reviews != nil && p.Reviews.Decision != "" {
+		return p.Reviews.Decision
+	}
+	return p.Venue
+}
@@ -0,0 +1,54 @@
+package paper
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPaper_GetDecision(t *testing.T) {
+	p := &Paper{Venue: "ICLR 2025 Poster"}
+	if got := p.GetDecision(); got != "ICLR 2025 Poster" {
+		t.Errorf("expected decision to fall back to venue, got %q", got)
+	}
+
+	p.Reviews = &Reviews{Decision: "Accept (Oral)"}
+	if got := p.GetDecision(); got != "Accept (Oral)" {
+		t.Errorf("expected decision from reviews, got %q", got)
+	}
+}
+
+func TestPaper_GetMeanRating(t *testing.T) {
+	if _, ok := (&Paper{}).GetMeanRating(); ok {
+		t.Error("expected no rating without reviews")
+	}
+	p := &Paper{Reviews: &Reviews{Ratings: []float64{6, 8}, Confidences: []float64{3, 4}}}
+	if rating, ok := p.GetMeanRating(); !ok || rating != 7 {
+		t.Errorf("expected mean rating 7, got %v (ok=%t)", rating, ok)
+	}
+	if got := p.Reviews.MeanConfidence(); got != 3.5 {
+		t.Errorf("expected mean confidence 3.5, got %v", got)
+	}
+}
+
+func TestPaper_GetCDate(t *testing.T) {
+	if got := (&Paper{}).GetCDate(); got != 0 {
+		t.Errorf("expected 0 for unknown date, got %d", got)
+	}
+	published := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	if got := (&Paper{Published: published}).GetCDate(); got != published.UnixMilli() {
+		t.Errorf("expected %d, got %d", published.UnixMilli(), got)
+	}
+}
+
+func TestReviews_IsEmpty(t *testing.T) {
+	var reviews *Reviews
+	if !reviews.IsEmpty() {
+		t.Error("expected nil reviews to be empty")
+	}
+	if !(&Reviews{NumReviews: 2}).IsEmpty() {
+		t.Error("expected reviews without decision or ratings to be empty")
+	}
+	if (&Reviews{Decision: "Reject"}).IsEmpty() {
+		t.Error("expected reviews with a decision not to be empty")
+	}
+}
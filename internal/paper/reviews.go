@@ -0,0 +1,35 @@
+package paper
+
+// Reviews は論文の査読結果の集計です。
+type Reviews struct {
+	Decision    string    // 採否 (例: "Accept (Oral)")。Decision がない場合はメタレビューの推薦、どちらもなければ空文字
+	NumReviews  int       // 査読 (Official Review) の件数
+	Ratings     []float64 // 各査読の評価スコア
+	Confidences []float64 // 各査読の確信度
+}
+
+// MeanRating は評価スコアの平均を返します。スコアがない場合は 0 を返します。
+func (r *Reviews) MeanRating() float64 {
+	return mean(r.Ratings)
+}
+
+// MeanConfidence は確信度の平均を返します。確信度がない場合は 0 を返します。
+func (r *Reviews) MeanConfidence() float64 {
+	return mean(r.Confidences)
+}
+
+// IsEmpty は表示できる査読結果がないかを判定します。
+func (r *Reviews) IsEmpty() bool {
+	return r == nil || (r.Decision == "" && len(r.Ratings) == 0)
+}
+
+func mean(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+	return sum / float64(len(values))
+}
@@ -0,0 +1,341 @@
+package readinglist
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// bibEntry は BibTeX のエントリ1件です。フィールド名は小文字に揃えています。
+type bibEntry struct {
+	Type   string // エントリの種類 (例: "inproceedings")
+	Key    string // 引用キー
+	Fields map[string]string
+}
+
+// monthMacros は BibTeX が定義済みの月のマクロです。
+var monthMacros = map[string]string{
+	"jan": "January", "feb": "February", "mar": "March", "apr": "April", "may": "May", "jun": "June",
+	"jul": "July", "aug": "August", "sep": "September", "oct": "October", "nov": "November", "dec": "December",
+}
+
+// parseBibTeX は BibTeX 形式のテキストからエントリを取り出します。
+// @string で定義したマクロ (と月のマクロ) はフィールドの値で展開し、@preamble / @comment は読み飛ばします。
+func parseBibTeX(src string) ([]bibEntry, error) {
+	p := &bibParser{src: []rune(src), macros: maps.Clone(monthMacros)}
+	var entries []bibEntry
+	for {
+		// エントリ以外のテキストはコメントとして扱う
+		at := p.indexFrom('@')
+		if at < 0 {
+			return entries, nil
+		}
+		p.pos = at + 1
+		typ := strings.ToLower(p.readIdent())
+		p.skipSpace()
+		open := p.peek()
+		if open != '{' && open != '(' {
+			continue // "@" を含むただのテキスト
+		}
+		close := '}'
+		if open == '(' {
+			close = ')'
+		}
+		p.pos++
+
+		switch typ {
+		case "comment", "preamble":
+			if err := p.skipBalanced(open, close); err != nil {
+				return nil, err
+			}
+			continue
+		case "string":
+			if err := p.readMacro(close); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		entry, err := p.readEntry(typ, close)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+}
+
+// bibParser は BibTeX のテキストを先頭から読み進めます。
+type bibParser struct {
+	src    []rune
+	pos    int
+	macros map[string]string // @string で定義したマクロ (名前は小文字)
+}
+
+func (p *bibParser) peek() rune {
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *bibParser) indexFrom(r rune) int {
+	for i := p.pos; i < len(p.src); i++ {
+		if p.src[i] == r {
+			return i
+		}
+	}
+	return -1
+}
+
+func (p *bibParser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(p.src[p.pos]) {
+		p.pos++
+	}
+}
+
+// readIdent はエントリの種類・引用キー・フィールド名を読みます。
+func (p *bibParser) readIdent() string {
+	start := p.pos
+	for p.pos < len(p.src) {
+		r := p.src[p.pos]
+		if unicode.IsSpace(r) || strings.ContainsRune("{}(),=#\"", r) {
+			break
+		}
+		p.pos++
+	}
+	return string(p.src[start:p.pos])
+}
+
+// skipBalanced は対応する閉じ括弧まで読み飛ばします。
+func (p *bibParser) skipBalanced(open, close rune) error {
+	depth := 1
+	for ; p.pos < len(p.src); p.pos++ {
+		switch p.src[p.pos] {
+		case open:
+			depth++
+		case close:
+			depth--
+			if depth == 0 {
+				p.pos++
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("unterminated entry at end of input")
+}
+
+// readEntry は "key, field = value, ..." を閉じ括弧まで読みます。
+func (p *bibParser) readEntry(typ string, close rune) (bibEntry, error) {
+	p.skipSpace()
+	entry := bibEntry{Type: typ, Key: p.readIdent(), Fields: map[string]string{}}
+	line := p.line()
+	for {
+		p.skipSpace()
+		switch p.peek() {
+		case ',':
+			p.pos++
+			continue
+		case close:
+			p.pos++
+			return entry, nil
+		case 0:
+			return bibEntry{}, fmt.Errorf("unterminated entry %q (line %d)", entry.Key, line)
+		}
+
+		name := strings.ToLower(p.readIdent())
+		if name == "" {
+			return bibEntry{}, fmt.Errorf("unexpected %q in entry %q (line %d)", p.peek(), entry.Key, p.line())
+		}
+		p.skipSpace()
+		if p.peek() != '=' {
+			return bibEntry{}, fmt.Errorf("expected '=' after field %q in entry %q (line %d)", name, entry.Key, p.line())
+		}
+		p.pos++
+		value, err := p.readValue()
+		if err != nil {
+			return bibEntry{}, fmt.Errorf("field %q in entry %q: %w", name, entry.Key, err)
+		}
+		entry.Fields[name] = value
+	}
+}
+
+// readMacro は @string{name = value} の定義を読み、以降の値で展開できるようにします。
+func (p *bibParser) readMacro(close rune) error {
+	line := p.line()
+	p.skipSpace()
+	name := strings.ToLower(p.readIdent())
+	p.skipSpace()
+	if name == "" || p.peek() != '=' {
+		return fmt.Errorf("invalid @string definition (line %d)", line)
+	}
+	p.pos++
+	value, err := p.readValue()
+	if err != nil {
+		return fmt.Errorf("@string %q: %w", name, err)
+	}
+	p.skipSpace()
+	if p.peek() == ',' {
+		p.pos++
+		p.skipSpace()
+	}
+	if p.peek() != close {
+		return fmt.Errorf("expected end of @string %q (line %d)", name, p.line())
+	}
+	p.pos++
+	p.macros[name] = value
+	return nil
+}
+
+// readValue は {...} / "..." / 数値・マクロ名を "#" で連結した値を読みます。
+// 定義済みのマクロは展開し、未定義の名前はそのまま使います。
+func (p *bibParser) readValue() (string, error) {
+	var b strings.Builder
+	for {
+		p.skipSpace()
+		switch p.peek() {
+		case '{':
+			p.pos++
+			start := p.pos
+			if err := p.skipBalanced('{', '}'); err != nil {
+				return "", err
+			}
+			b.WriteString(string(p.src[start : p.pos-1]))
+		case '"':
+			p.pos++
+			start, depth := p.pos, 0
+			for ; p.pos < len(p.src); p.pos++ {
+				r := p.src[p.pos]
+				if r == '{' {
+					depth++
+				} else if r == '}' {
+					depth--
+				} else if r == '"' && depth == 0 {
+					break
+				}
+			}
+			if p.pos >= len(p.src) {
+				return "", fmt.Errorf("unterminated quoted value")
+			}
+			b.WriteString(string(p.src[start:p.pos]))
+			p.pos++
+		default:
+			ident := p.readIdent()
+			if value, ok := p.macros[strings.ToLower(ident)]; ok {
+				ident = value
+			}
+			b.WriteString(ident) // 数値、マクロの値、または未定義のマクロ名
+		}
+
+		p.skipSpace()
+		if p.peek() != '#' {
+			return b.String(), nil
+		}
+		p.pos++
+	}
+}
+
+// line は現在位置の行番号 (1始まり) を返します。
+func (p *bibParser) line() int {
+	n := 1
+	for _, r := range p.src[:min(p.pos, len(p.src))] {
+		if r == '\n' {
+			n++
+		}
+	}
+	return n
+}
+
+// latexReplacer は表示に使う値から LaTeX のエスケープと改行しないスペースを取り除きます。
+var latexReplacer = strings.NewReplacer(`\&`, "&", `\%`, "%", `\_`, "_", `\$`, "$", `\#`, "#", "~", " ", "{", "", "}", "", "---", "—", "--", "–")
+
+// latexSymbols は文字に置き換える LaTeX のコマンドです。これ以外のコマンドは取り除きます。
+var latexSymbols = map[string]string{
+	"LaTeX": "LaTeX", "TeX": "TeX", "i": "i", "ss": "ß", "aa": "å", "AA": "Å", "ae": "æ", "AE": "Æ",
+	"oe": "œ", "OE": "Œ", "o": "ø", "O": "Ø", "l": "ł", "L": "Ł",
+}
+
+var (
+	// letterAccentPattern は \c{c} / \v s のような英字のアクセント記号のコマンドに一致します。
+	letterAccentPattern = regexp.MustCompile(`\\([cv])(?:\s*\{\s*([A-Za-z])\s*\}|\s+([A-Za-z]))`)
+	// latexCommandPattern は \emph や \ss のようなコマンド名に一致します。
+	// 後ろの空白は単語の区切りとして残し、引数の "{" の前の空白 (\emph {x}) だけを1つ含めます。
+	latexCommandPattern = regexp.MustCompile(`\\([A-Za-z]+)(?: ?(\{))?`)
+	// symbolAccentPattern は \"{u} / \"u / \'{e} のような記号のアクセント記号のコマンドに一致します。
+	symbolAccentPattern = regexp.MustCompile(`\\(["'\x60^~])\s*(?:\{\s*([A-Za-z])\s*\}|([A-Za-z]))`)
+)
+
+// accentedLetters はアクセント記号ごとに、基底の文字とアクセント付きの文字を同じ順に並べたものです。
+var accentedLetters = map[string][2]string{
+	`"`: {"aeiouyAEIOUY", "äëïöüÿÄËÏÖÜŸ"},
+	`'`: {"aeiouycnszAEIOUYCNSZ", "áéíóúýćńśźÁÉÍÓÚÝĆŃŚŹ"},
+	"`": {"aeiouAEIOU", "àèìòùÀÈÌÒÙ"},
+	`^`: {"aeiouAEIOU", "âêîôûÂÊÎÔÛ"},
+	`~`: {"anoANO", "ãñõÃÑÕ"},
+	`c`: {"csCS", "çşÇŞ"},
+	`v`: {"cszrneCSZRNE", "čšžřňěČŠŽŘŇĚ"},
+}
+
+// replaceAccents は re に一致したアクセント記号のコマンドをアクセント付きの文字に置き換えます。
+// 対応表にない組み合わせはアクセントを外した基底の文字にします。
+func replaceAccents(re *regexp.Regexp, s string) string {
+	return re.ReplaceAllStringFunc(s, func(m string) string {
+		sub := re.FindStringSubmatch(m)
+		base := sub[2] + sub[3]
+		table := accentedLetters[sub[1]]
+		if i := strings.Index(table[0], base); i >= 0 {
+			return string([]rune(table[1])[i])
+		}
+		return base
+	})
+}
+
+// cleanLaTeX は BibTeX の値を表示用のテキストに変換します。
+// アクセント記号 (M{\"u}ller → Müller) と代表的な記号のコマンドを文字に置き換え、その他のコマンド (\emph など) は取り除いて引数だけを残します。
+func cleanLaTeX(s string) string {
+	s = replaceAccents(letterAccentPattern, s)
+	s = latexCommandPattern.ReplaceAllStringFunc(s, func(m string) string {
+		sub := latexCommandPattern.FindStringSubmatch(m)
+		return latexSymbols[sub[1]] + sub[2]
+	})
+	s = replaceAccents(symbolAccentPattern, s) // \'{\i} は \i を置き換えてから
+	return strings.Join(strings.Fields(latexReplacer.Replace(s)), " ")
+}
+
+// splitBibAuthors は "Last, First and First Last" 形式の著者リストを "First Last" のリストに変換します。
+func splitBibAuthors(s string) []string {
+	var authors []string
+	for _, raw := range splitAnd(strings.Join(strings.Fields(s), " ")) {
+		raw = strings.TrimSpace(raw)
+		name := cleanLaTeX(raw)
+		corporate := strings.HasPrefix(raw, "{") && strings.HasSuffix(raw, "}") // {Microsoft Research, Asia} のような団体名はそのまま使う
+		if last, first, ok := strings.Cut(name, ", "); ok && !corporate {
+			name = first + " " + last
+		}
+		if name != "" {
+			authors = append(authors, name)
+		}
+	}
+	return authors
+}
+
+// splitAnd は波括弧の外にある " and " で区切ります。
+func splitAnd(s string) []string {
+	var parts []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '{':
+			depth++
+		case '}':
+			depth--
+		}
+		if depth == 0 && i+5 <= len(s) && strings.EqualFold(s[i:i+5], " and ") {
+			parts = append(parts, s[start:i])
+			start = i + 5
+			i += 4
+		}
+	}
+	return append(parts, s[start:])
+}
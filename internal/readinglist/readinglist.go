@@ -0,0 +1,255 @@
+// Package readinglist はローカルの BibTeX / CSV ファイル (輪読会の論文リストなど) を論文の取得元として扱います。
+package readinglist
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+)
+
+var ErrPaperNotFound = errors.New("paper not found in reading lists")
+
+// yearPattern は year フィールド・列から西暦を取り出します。
+var yearPattern = regexp.MustCompile(`\d{4}`)
+
+// Source は BibTeX / CSV ファイルから論文を読み込む取得元です。
+// 学会設定の Venue をファイルパス、Type をファイル形式 (config.SourceBibTeX / config.SourceCSV) として扱います。
+type Source struct {
+	lists []config.VenueConfig // GetPaper で探すリスト
+}
+
+// NewSource は venues のうち type が bibtex / csv の設定を GetPaper の検索対象とする Source を生成します。
+func NewSource(venues []config.VenueConfig) *Source {
+	s := &Source{}
+	for _, v := range venues {
+		if IsReadingList(v) {
+			s.lists = append(s.lists, v)
+		}
+	}
+	return s
+}
+
+// PaperID は論文リストの論文 ID を "<format>:<path>#<key>" の形式で返します (例: "bibtex:assets/reading.bib#vaswani2017attention")。
+// 別のリストで同じ引用キーや id を使っても、投稿履歴や OpenReview の論文 ID と衝突しないようにファイル形式とパスを含めます。
+func PaperID(format, path, key string) string {
+	return format + ":" + path + "#" + key
+}
+
+// IsPaperID は id が論文リストの論文 ID ("bibtex:" または "csv:" で始まる) かどうかを判定します。
+func IsPaperID(id string) bool {
+	return strings.HasPrefix(id, config.SourceBibTeX+":") || strings.HasPrefix(id, config.SourceCSV+":")
+}
+
+// IsReadingList は学会設定がローカルファイルの論文リストかどうかを判定します。
+func IsReadingList(venue config.VenueConfig) bool {
+	return venue.Type == config.SourceBibTeX || venue.Type == config.SourceCSV
+}
+
+// ListPapers はファイルの全ての論文を記載順に返します。
+func (s *Source) ListPapers(_ context.Context, venue config.VenueConfig) ([]*paper.Paper, error) {
+	return Load(venue.Venue, venue.Type)
+}
+
+// CountPapers はファイルに記載された論文の件数を返します。
+func (s *Source) CountPapers(ctx context.Context, venue config.VenueConfig) (int, error) {
+	papers, err := s.ListPapers(ctx, venue)
+	return len(papers), err
+}
+
+// GetPaper は NewSource に渡したリストのうち、ID (PaperID の形式) のファイル形式とパスに一致するリストから論文を探します。
+// 見つからない場合は ErrPaperNotFound を返します。
+func (s *Source) GetPaper(ctx context.Context, id string) (*paper.Paper, error) {
+	for _, list := range s.lists {
+		if !strings.HasPrefix(id, PaperID(list.Type, list.Venue, "")) {
+			continue
+		}
+		papers, err := s.ListPapers(ctx, list)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range papers {
+			if p.ID == id {
+				return p, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: %s", ErrPaperNotFound, id)
+}
+
+// Load は path のファイルを format (config.SourceBibTeX / config.SourceCSV) として読み込みます。
+// 論文の ID は PaperID の形式、VenueID は path です。ID (引用キーなど) がない論文や、同じ ID の論文が複数ある場合はエラーを返します。
+func Load(path, format string) ([]*paper.Paper, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read reading list %s: %w", path, err)
+	}
+
+	var papers []*paper.Paper
+	switch format {
+	case config.SourceBibTeX:
+		papers, err = fromBibTeX(string(data))
+	case config.SourceCSV:
+		papers, err = fromCSV(string(data))
+	default:
+		return nil, fmt.Errorf("unsupported reading list format: %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse reading list %s: %w", path, err)
+	}
+	seen := map[string]bool{}
+	for i, p := range papers {
+		if p.ID == "" {
+			return nil, fmt.Errorf("failed to parse reading list %s: entry %d has no id", path, i+1)
+		}
+		if seen[p.ID] {
+			return nil, fmt.Errorf("failed to parse reading list %s: duplicate id %q", path, p.ID)
+		}
+		seen[p.ID] = true
+		p.ID = PaperID(format, path, p.ID)
+		p.Source = format
+		p.VenueID = path
+	}
+	return papers, nil
+}
+
+// fromBibTeX は BibTeX のエントリを論文に変換します。ID は引用キーです (Metadata の citation_key にも入ります)。
+func fromBibTeX(src string) ([]*paper.Paper, error) {
+	entries, err := parseBibTeX(src)
+	if err != nil {
+		return nil, err
+	}
+	papers := make([]*paper.Paper, 0, len(entries))
+	for _, e := range entries {
+		f := e.Fields
+		p := &paper.Paper{
+			ID:       e.Key,
+			Title:    cleanLaTeX(f["title"]),
+			Authors:  splitBibAuthors(f["author"]),
+			Abstract: cleanLaTeX(f["abstract"]),
+			Keywords: splitList(cleanLaTeX(f["keywords"])),
+			Venue:    cleanLaTeX(firstNonEmpty(f["booktitle"], f["journal"], f["publisher"])),
+			Metadata: map[string]string{"entry_type": e.Type, "citation_key": e.Key},
+		}
+		for name, value := range f {
+			p.Metadata[name] = cleanLaTeX(value)
+		}
+		p.URL, p.PDFURL = links(cleanLaTeX(f["url"]), cleanLaTeX(f["doi"]), cleanLaTeX(f["pdf"]))
+		p.Published = parseYear(f["year"])
+		papers = append(papers, p)
+	}
+	return papers, nil
+}
+
+// fromCSV はヘッダー行付きの CSV を論文に変換します。
+// 列名は大文字小文字を区別せず、title / authors (splitCSVAuthors を参照) / abstract / url / doi / pdf / venue / year / keywords を読みます。
+// ID は id 列、なければ doi → url → title の順に使います。その他の列は Metadata に入ります。
+func fromCSV(src string) ([]*paper.Paper, error) {
+	r := csv.NewReader(strings.NewReader(strings.TrimPrefix(src, "\ufeff"))) // Excel が付ける BOM を除く
+	r.TrimLeadingSpace = true
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	header := make([]string, len(records[0]))
+	for i, name := range records[0] {
+		header[i] = strings.ToLower(strings.TrimSpace(name))
+	}
+	if !slices.Contains(header, "title") {
+		return nil, fmt.Errorf("missing 'title' column")
+	}
+
+	papers := make([]*paper.Paper, 0, len(records)-1)
+	for _, record := range records[1:] {
+		f := map[string]string{}
+		for i, value := range record {
+			f[header[i]] = strings.TrimSpace(value)
+		}
+		p := &paper.Paper{
+			ID:       firstNonEmpty(f["id"], f["doi"], f["url"], f["title"]),
+			Title:    f["title"],
+			Authors:  splitCSVAuthors(firstNonEmpty(f["authors"], f["author"])),
+			Abstract: f["abstract"],
+			Keywords: splitList(f["keywords"]),
+			Venue:    f["venue"],
+			Metadata: f,
+		}
+		p.URL, p.PDFURL = links(f["url"], f["doi"], f["pdf"])
+		p.Published = parseYear(f["year"])
+		papers = append(papers, p)
+	}
+	return papers, nil
+}
+
+// links は論文ページと PDF の URL を決めます。url がなければ DOI のリンク、url が PDF ならそれを PDF としても使います。
+func links(url, doi, pdf string) (string, string) {
+	if url == "" && doi != "" {
+		url = "https://doi.org/" + strings.TrimPrefix(doi, "https://doi.org/")
+	}
+	if pdf == "" && strings.HasSuffix(strings.ToLower(url), ".pdf") {
+		pdf = url
+	}
+	return url, pdf
+}
+
+// parseYear は年を1月1日の日時に変換します。年がない場合はゼロ値を返します。
+func parseYear(s string) time.Time {
+	year, err := strconv.Atoi(yearPattern.FindString(s))
+	if err != nil {
+		return time.Time{}
+	}
+	return time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+}
+
+// splitCSVAuthors は ";" または " and " 区切りの著者リストを分割します。
+// "," では区切らず、"Vaswani, Ashish" のような "Last, First" 形式の著者は BibTeX と同じく "Ashish Vaswani" にします。
+func splitCSVAuthors(s string) []string {
+	var authors []string
+	for _, name := range strings.Split(strings.ReplaceAll(s, " and ", ";"), ";") {
+		name = strings.Join(strings.Fields(name), " ")
+		if last, first, ok := strings.Cut(name, ", "); ok && !strings.Contains(first, ",") {
+			name = first + " " + last
+		}
+		if name != "" {
+			authors = append(authors, name)
+		}
+	}
+	return authors
+}
+
+// splitList は ";" または "," 区切りのリストを分割します。";" を含む場合は ";" のみで区切ります。
+func splitList(s string) []string {
+	sep := ","
+	if strings.Contains(s, ";") {
+		sep = ";"
+	}
+	var items []string
+	for _, item := range strings.Split(s, sep) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
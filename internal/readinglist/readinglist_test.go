@@ -0,0 +1,275 @@
+package readinglist
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+)
+
+func TestLoad_BibTeX(t *testing.T) {
+	path := filepath.Join("testdata", "reading.bib")
+	papers, err := Load(path, config.SourceBibTeX)
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if len(papers) != 3 {
+		t.Fatalf("expected 3 papers (@string and @comment skipped), got %d", len(papers))
+	}
+
+	t.Run("braced fields and multi-line authors", func(t *testing.T) {
+		p := papers[0]
+		if p.ID != "bibtex:"+path+"#vaswani2017attention" || p.Title != "Attention Is All You Need" {
+			t.Errorf("unexpected paper: %s %q", p.ID, p.Title)
+		}
+		wantAuthors := []string{"Ashish Vaswani", "Noam Shazeer", "Niki Parmar", "Jakob Uszkoreit"}
+		if !reflect.DeepEqual(p.Authors, wantAuthors) {
+			t.Errorf("expected authors %v, got %v", wantAuthors, p.Authors)
+		}
+		if p.Abstract != "The dominant sequence transduction models are based on complex recurrent or convolutional neural networks." {
+			t.Errorf("abstract whitespace not normalized: %q", p.Abstract)
+		}
+		if !reflect.DeepEqual(p.Keywords, []string{"transformer", "attention"}) {
+			t.Errorf("unexpected keywords: %v", p.Keywords)
+		}
+		if p.URL != "https://arxiv.org/abs/1706.03762" || p.PDFURL != "" {
+			t.Errorf("unexpected links: %q / %q", p.URL, p.PDFURL)
+		}
+		if p.Venue != "Advances in Neural Information Processing Systems" {
+			t.Errorf("expected @string macro to be expanded in venue, got %q", p.Venue)
+		}
+		if p.Published.Year() != 2017 {
+			t.Errorf("expected year 2017, got %v", p.Published)
+		}
+		if p.Source != config.SourceBibTeX || p.VenueID != filepath.Join("testdata", "reading.bib") {
+			t.Errorf("unexpected source/venueid: %q / %q", p.Source, p.VenueID)
+		}
+		if p.Metadata["entry_type"] != "inproceedings" || p.Metadata["citation_key"] != "vaswani2017attention" {
+			t.Errorf("expected entry type in metadata, got %v", p.Metadata)
+		}
+	})
+
+	t.Run("quoted fields, DOI link and LaTeX cleanup", func(t *testing.T) {
+		p := papers[1]
+		if !reflect.DeepEqual(p.Authors, []string{"Kaiming He", "Xiangyu Zhang", "Microsoft Research, Asia"}) {
+			t.Errorf("unexpected authors: %v", p.Authors)
+		}
+		if p.Venue != "CVPR" || p.URL != "https://doi.org/10.1109/CVPR.2016.90" {
+			t.Errorf("unexpected venue/url: %q / %q", p.Venue, p.URL)
+		}
+		if p.Metadata["note"] != "Pages 770–778 & more" {
+			t.Errorf("unexpected note: %q", p.Metadata["note"])
+		}
+	})
+
+	t.Run("pdf url", func(t *testing.T) {
+		p := papers[2]
+		if p.Title != "Lab Notes on LaTeX Tricks" {
+			t.Errorf("unexpected title: %q", p.Title)
+		}
+		wantAuthors := []string{"Jörg Müller", "François García", "Antonín Dvořák"}
+		if !reflect.DeepEqual(p.Authors, wantAuthors) {
+			t.Errorf("expected accents to be converted: want %v, got %v", wantAuthors, p.Authors)
+		}
+		if p.Metadata["month"] != "January" {
+			t.Errorf("expected month macro to be expanded, got %q", p.Metadata["month"])
+		}
+		if p.PDFURL != "https://example.com/notes.pdf" {
+			t.Errorf("expected pdf url from url field, got %q", p.PDFURL)
+		}
+		if !p.Published.IsZero() {
+			t.Errorf("expected zero date without year, got %v", p.Published)
+		}
+	})
+}
+
+func TestLoad_CSV(t *testing.T) {
+	path := filepath.Join("testdata", "reading.csv")
+	papers, err := Load(path, config.SourceCSV)
+	if err != nil {
+		t.Fatalf("Load() failed: %v", err)
+	}
+	if len(papers) != 2 {
+		t.Fatalf("expected 2 papers, got %d", len(papers))
+	}
+
+	p := papers[0]
+	if p.ID != "csv:"+path+"#rg-01" || p.Title != "Attention Is All You Need" || p.Venue != "NeurIPS" {
+		t.Errorf("unexpected paper: %+v", p)
+	}
+	if !reflect.DeepEqual(p.Authors, []string{"Ashish Vaswani", "Noam Shazeer"}) {
+		t.Errorf("unexpected authors: %v", p.Authors)
+	}
+	if p.Abstract != "The dominant sequence transduction models, revisited." {
+		t.Errorf("unexpected abstract: %q", p.Abstract)
+	}
+	if !reflect.DeepEqual(p.Keywords, []string{"transformer", "attention"}) {
+		t.Errorf("unexpected keywords: %v", p.Keywords)
+	}
+	if !p.Published.Equal(time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected published date: %v", p.Published)
+	}
+	if p.Metadata["presenter"] != "Alice" {
+		t.Errorf("expected extra column in metadata, got %v", p.Metadata)
+	}
+
+	q := papers[1]
+	if q.ID != "csv:"+path+"#10.1109/CVPR.2016.90" {
+		t.Errorf("expected DOI as fallback ID, got %q", q.ID)
+	}
+	if !reflect.DeepEqual(q.Authors, []string{"Kaiming He", "Xiangyu Zhang"}) {
+		t.Errorf("expected authors split on 'and', got %v", q.Authors)
+	}
+	if q.URL != "https://doi.org/10.1109/CVPR.2016.90" {
+		t.Errorf("unexpected url: %q", q.URL)
+	}
+}
+
+func TestParseBibTeX_Macros(t *testing.T) {
+	src := `@String(conf = "Proc. ")
+@string{NeurIPS = conf # "NeurIPS"}
+@misc{a, booktitle = neurips # { 2024}, month = Feb, note = undefined, year = 2024}`
+	entries, err := parseBibTeX(src)
+	if err != nil {
+		t.Fatalf("parseBibTeX() failed: %v", err)
+	}
+	want := map[string]string{"booktitle": "Proc. NeurIPS 2024", "month": "February", "note": "undefined", "year": "2024"}
+	if len(entries) != 1 || !reflect.DeepEqual(entries[0].Fields, want) {
+		t.Errorf("expected fields %v, got %+v", want, entries)
+	}
+}
+
+func TestCleanLaTeX(t *testing.T) {
+	tests := map[string]string{
+		`M{\"u}ller`:                      "Müller",
+		`\"{O}zg\"ur`:                     "Özgür",
+		"Jos\\'e and \\`A \\^ete na\\~na": "José and À ête naña",
+		`Fran\c{c}ois Dvo\v{r}\'ak`:       "François Dvořák",
+		`Erd{\H{o}}s`:                     "Erdos",
+		`Stra{\ss}e, {\o}re, Garc{\'\i}a`: "Straße, øre, García",
+		`Hello \LaTeX world, \TeX book`:   "Hello LaTeX world, TeX book",
+		`\emph {Deep}learning`:            "Deeplearning",
+		`\emph{Deep} \textbf{Learning}`:   "Deep Learning",
+		`a\ldots b, \omega`:               "a b,",
+	}
+	for in, want := range tests {
+		if got := cleanLaTeX(in); got != want {
+			t.Errorf("cleanLaTeX(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestSplitCSVAuthors(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{"Vaswani, Ashish", []string{"Ashish Vaswani"}},
+		{"Vaswani, Ashish; Shazeer, Noam", []string{"Ashish Vaswani", "Noam Shazeer"}},
+		{"Kaiming He and Xiangyu Zhang", []string{"Kaiming He", "Xiangyu Zhang"}},
+		{"Ashish Vaswani;  Noam  Shazeer ;", []string{"Ashish Vaswani", "Noam Shazeer"}},
+		{"Smith, Jr., John", []string{"Smith, Jr., John"}},
+		{"", nil},
+	}
+	for _, tt := range tests {
+		if got := splitCSVAuthors(tt.in); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitCSVAuthors(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name, content string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	tests := []struct {
+		name   string
+		path   string
+		format string
+	}{
+		{"missing file", filepath.Join(dir, "missing.bib"), config.SourceBibTeX},
+		{"unterminated entry", write("broken.bib", "@article{key, title = {Open"), config.SourceBibTeX},
+		{"missing equals", write("noeq.bib", "@article{key, title {T}}"), config.SourceBibTeX},
+		{"csv without title column", write("notitle.csv", "id,url\n1,https://example.com\n"), config.SourceCSV},
+		{"unsupported format", write("list.txt", "x"), "txt"},
+		{"duplicate bibtex key", write("dup.bib", "@article{key, title = {A}}\n@misc{key, title = {B}}"), config.SourceBibTeX},
+		{"empty bibtex key", write("nokey.bib", "@article{, title = {A}}"), config.SourceBibTeX},
+		{"duplicate csv id", write("dup.csv", "id,title\nrg-01,A\nrg-01,B\n"), config.SourceCSV},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Load(tt.path, tt.format); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestSource(t *testing.T) {
+	venues := []config.VenueConfig{
+		{Name: "ICLR", Venue: "ICLR.cc/2025/Conference", Type: config.SourceOpenReview},
+		{Name: "Reading group", Venue: filepath.Join("testdata", "reading.bib"), Type: config.SourceBibTeX},
+		{Name: "Backlog", Venue: filepath.Join("testdata", "reading.csv"), Type: config.SourceCSV},
+	}
+	s := NewSource(venues)
+	ctx := context.Background()
+
+	t.Run("count papers", func(t *testing.T) {
+		n, err := s.CountPapers(ctx, venues[2])
+		if err != nil || n != 2 {
+			t.Errorf("expected 2 papers, got %d (err=%v)", n, err)
+		}
+	})
+
+	t.Run("get paper by namespaced id", func(t *testing.T) {
+		id := PaperID(config.SourceCSV, venues[2].Venue, "rg-01")
+		p, err := s.GetPaper(ctx, id)
+		if err != nil {
+			t.Fatalf("GetPaper() failed: %v", err)
+		}
+		if p.ID != id || p.VenueID != venues[2].Venue {
+			t.Errorf("expected paper from the csv list, got %q in %q", p.ID, p.VenueID)
+		}
+	})
+
+	for name, id := range map[string]string{
+		"bare key":          "rg-01",
+		"unknown key":       PaperID(config.SourceCSV, venues[2].Venue, "nope"),
+		"unconfigured list": PaperID(config.SourceCSV, filepath.Join("testdata", "other.csv"), "rg-01"),
+		"format mismatch":   PaperID(config.SourceBibTeX, venues[2].Venue, "rg-01"),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := s.GetPaper(ctx, id); !errors.Is(err, ErrPaperNotFound) {
+				t.Errorf("expected ErrPaperNotFound for %q, got %v", id, err)
+			}
+		})
+	}
+
+	t.Run("same key in two lists does not collide", func(t *testing.T) {
+		dir := t.TempDir()
+		for _, name := range []string{"2024.csv", "2025.csv"} {
+			if err := os.WriteFile(filepath.Join(dir, name), []byte("id,title\nrg-01,"+name+"\n"), 0o644); err != nil {
+				t.Fatal(err)
+			}
+		}
+		a, errA := Load(filepath.Join(dir, "2024.csv"), config.SourceCSV)
+		b, errB := Load(filepath.Join(dir, "2025.csv"), config.SourceCSV)
+		if errA != nil || errB != nil {
+			t.Fatalf("Load() failed: %v / %v", errA, errB)
+		}
+		if a[0].ID == b[0].ID {
+			t.Errorf("expected distinct ids, both are %q", a[0].ID)
+		}
+	})
+}
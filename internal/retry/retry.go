@@ -0,0 +1,184 @@
+package retry
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Policy は HTTP リクエストのリトライ方針を表します。
+type Policy struct {
+	MaxAttempts int           // 最大試行回数 (初回を含む)。1以下ならリトライしない
+	BaseDelay   time.Duration // 初回リトライまでの待機時間。以降は試行ごとに倍になる
+	MaxDelay    time.Duration // 1回あたりの待機時間の上限。Retry-After がこれを超える場合はリトライしない
+
+	// sleep はテストで待機を差し替えられるようにするためのフックです。
+	sleep func(req *http.Request, d time.Duration) error
+}
+
+// DefaultPolicy はデフォルトのリトライ方針を返します。
+func DefaultPolicy() Policy {
+	return Policy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Second,
+		MaxDelay:    30 * time.Second,
+	}
+}
+
+// NoRetry はリトライを行わない方針を返します。
+func NoRetry() Policy {
+	return Policy{MaxAttempts: 1}
+}
+
+// MarkIdempotent は POST 等のメソッドでも安全に再送できるリクエストであることを示します。
+// net/http の慣習に従い X-Idempotency-Key に nil を設定するため、ヘッダは実際には送信されません。
+func MarkIdempotent(req *http.Request) {
+	req.Header["X-Idempotency-Key"] = nil
+}
+
+// isIdempotent はリクエストが再送しても副作用のないものかどうかを判定します。
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	if _, ok := req.Header["Idempotency-Key"]; ok {
+		return true
+	}
+	if _, ok := req.Header["X-Idempotency-Key"]; ok {
+		return true
+	}
+	return false
+}
+
+// Do は方針に従ってリクエストを実行します。
+// 429 はサーバーが処理せずに拒否したものとして常にリトライし、
+// 5xx と一時的なネットワークエラーは冪等なリクエストのみリトライします。
+// リトライ時のリクエストボディは req.GetBody から再生成します。
+func (p Policy) Do(client *http.Client, req *http.Request) (*http.Response, error) {
+	maxAttempts := p.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	if req.Body != nil && req.GetBody == nil {
+		maxAttempts = 1 // ボディを再生成できないため再送不可
+	}
+
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to rewind request body: %w", err)
+			}
+			req.Body = body
+		}
+
+		resp, err := client.Do(req)
+		if attempt >= maxAttempts || !p.shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		delay := p.backoff(attempt)
+		if resp != nil {
+			if after, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+				if p.MaxDelay > 0 && after > p.MaxDelay {
+					return resp, nil // 待機が長すぎるため諦めて呼び出し元に判断を委ねる
+				}
+				delay = after
+			}
+			// コネクションを再利用できるようにボディを読み捨てる
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+		}
+
+		if err := p.wait(req, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// shouldRetry はレスポンスまたはエラーがリトライ対象かどうかを判定します。
+func (p Policy) shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		if req.Context().Err() != nil {
+			return false
+		}
+		return isIdempotent(req) && isTransient(err)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req)
+	}
+	return false
+}
+
+// isTransient は一時的なネットワークエラーかどうかを判定します。
+func isTransient(err error) bool {
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	var opErr *net.OpError
+	if errors.As(err, &opErr) {
+		return true
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF)
+}
+
+// backoff は attempt 回目の失敗後の待機時間を、フルジッター付き指数バックオフで計算します。
+func (p Policy) backoff(attempt int) time.Duration {
+	if p.BaseDelay <= 0 {
+		return 0
+	}
+	d := p.BaseDelay << (attempt - 1)
+	if d <= 0 || (p.MaxDelay > 0 && d > p.MaxDelay) {
+		d = p.MaxDelay
+	}
+	return time.Duration(rand.Int63n(int64(d) + 1))
+}
+
+// wait は指定時間待機します。リクエストのコンテキストがキャンセルされた場合はエラーを返します。
+func (p Policy) wait(req *http.Request, d time.Duration) error {
+	if p.sleep != nil {
+		return p.sleep(req, d)
+	}
+	if d <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
+	}
+}
+
+// parseRetryAfter は Retry-After ヘッダ (秒数または HTTP-date) を解釈します。
+func parseRetryAfter(v string) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return 0, false
+		}
+		return time.Duration(secs) * time.Second, true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		d := time.Until(t)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
@@ -0,0 +1,225 @@
+package retry
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// newFlakyServer は最初の failures 回は status を返し、その後 200 を返すテスト用サーバーです。
+func newFlakyServer(t *testing.T, failures, status int, header http.Header, bodies *[]string) (*httptest.Server, *int) {
+	t.Helper()
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if bodies != nil {
+			b, _ := io.ReadAll(r.Body)
+			*bodies = append(*bodies, string(b))
+		}
+		if calls <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	return server, &calls
+}
+
+// testPolicy は待機時間を記録するだけで実際には待たない Policy を返します。
+func testPolicy(maxAttempts int, waits *[]time.Duration) Policy {
+	return Policy{
+		MaxAttempts: maxAttempts,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    time.Minute,
+		sleep: func(_ *http.Request, d time.Duration) error {
+			*waits = append(*waits, d)
+			return nil
+		},
+	}
+}
+
+func TestPolicy_Do(t *testing.T) {
+	t.Run("GET retries 5xx until success", func(t *testing.T) {
+		server, calls := newFlakyServer(t, 2, http.StatusServiceUnavailable, nil, nil)
+		defer server.Close()
+
+		var waits []time.Duration
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		resp, err := testPolicy(3, &waits).Do(http.DefaultClient, req)
+		if err != nil {
+			t.Fatalf("Do() failed: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected 200, got %d", resp.StatusCode)
+		}
+		if *calls != 3 {
+			t.Errorf("expected 3 calls, got %d", *calls)
+		}
+		if len(waits) != 2 {
+			t.Errorf("expected 2 waits, got %d", len(waits))
+		}
+	})
+
+	t.Run("gives up after MaxAttempts and returns last response", func(t *testing.T) {
+		server, calls := newFlakyServer(t, 5, http.StatusBadGateway, nil, nil)
+		defer server.Close()
+
+		var waits []time.Duration
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		resp, err := testPolicy(3, &waits).Do(http.DefaultClient, req)
+		if err != nil {
+			t.Fatalf("Do() failed: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusBadGateway {
+			t.Errorf("expected 502, got %d", resp.StatusCode)
+		}
+		if *calls != 3 {
+			t.Errorf("expected 3 calls, got %d", *calls)
+		}
+	})
+
+	t.Run("POST is not retried on 5xx", func(t *testing.T) {
+		server, calls := newFlakyServer(t, 1, http.StatusInternalServerError, nil, nil)
+		defer server.Close()
+
+		var waits []time.Duration
+		req, _ := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader([]byte("x")))
+		resp, err := testPolicy(3, &waits).Do(http.DefaultClient, req)
+		if err != nil {
+			t.Fatalf("Do() failed: %v", err)
+		}
+		defer resp.Body.Close()
+		if *calls != 1 {
+			t.Errorf("expected 1 call, got %d", *calls)
+		}
+	})
+
+	t.Run("POST marked idempotent is retried with body replayed", func(t *testing.T) {
+		var bodies []string
+		server, calls := newFlakyServer(t, 1, http.StatusInternalServerError, nil, &bodies)
+		defer server.Close()
+
+		var waits []time.Duration
+		req, _ := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader([]byte("payload")))
+		MarkIdempotent(req)
+		resp, err := testPolicy(3, &waits).Do(http.DefaultClient, req)
+		if err != nil {
+			t.Fatalf("Do() failed: %v", err)
+		}
+		defer resp.Body.Close()
+		if *calls != 2 {
+			t.Errorf("expected 2 calls, got %d", *calls)
+		}
+		for i, b := range bodies {
+			if b != "payload" {
+				t.Errorf("request %d body = %q, want %q", i, b, "payload")
+			}
+		}
+	})
+
+	t.Run("429 honors Retry-After even for POST", func(t *testing.T) {
+		header := http.Header{"Retry-After": []string{"7"}}
+		server, calls := newFlakyServer(t, 1, http.StatusTooManyRequests, header, nil)
+		defer server.Close()
+
+		var waits []time.Duration
+		req, _ := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader([]byte("x")))
+		resp, err := testPolicy(3, &waits).Do(http.DefaultClient, req)
+		if err != nil {
+			t.Fatalf("Do() failed: %v", err)
+		}
+		defer resp.Body.Close()
+		if *calls != 2 {
+			t.Errorf("expected 2 calls, got %d", *calls)
+		}
+		if len(waits) != 1 || waits[0] != 7*time.Second {
+			t.Errorf("expected single wait of 7s, got %v", waits)
+		}
+	})
+
+	t.Run("Retry-After beyond MaxDelay stops retrying", func(t *testing.T) {
+		header := http.Header{"Retry-After": []string{"3600"}}
+		server, calls := newFlakyServer(t, 1, http.StatusTooManyRequests, header, nil)
+		defer server.Close()
+
+		var waits []time.Duration
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		resp, err := testPolicy(3, &waits).Do(http.DefaultClient, req)
+		if err != nil {
+			t.Fatalf("Do() failed: %v", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusTooManyRequests {
+			t.Errorf("expected 429, got %d", resp.StatusCode)
+		}
+		if *calls != 1 {
+			t.Errorf("expected 1 call, got %d", *calls)
+		}
+	})
+
+	t.Run("4xx other than 429 is not retried", func(t *testing.T) {
+		server, calls := newFlakyServer(t, 1, http.StatusBadRequest, nil, nil)
+		defer server.Close()
+
+		var waits []time.Duration
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		resp, err := testPolicy(3, &waits).Do(http.DefaultClient, req)
+		if err != nil {
+			t.Fatalf("Do() failed: %v", err)
+		}
+		defer resp.Body.Close()
+		if *calls != 1 {
+			t.Errorf("expected 1 call, got %d", *calls)
+		}
+	})
+
+	t.Run("GET retries network errors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close() // closed before call so connection is refused
+
+		var waits []time.Duration
+		req, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+		_, err := testPolicy(3, &waits).Do(http.DefaultClient, req)
+		if err == nil {
+			t.Fatal("expected error for connection refused")
+		}
+		if len(waits) != 2 {
+			t.Errorf("expected 2 waits before giving up, got %d", len(waits))
+		}
+	})
+}
+
+func TestPolicy_Backoff(t *testing.T) {
+	p := Policy{BaseDelay: 100 * time.Millisecond, MaxDelay: 300 * time.Millisecond}
+	for attempt := 1; attempt <= 10; attempt++ {
+		d := p.backoff(attempt)
+		limit := p.BaseDelay << (attempt - 1)
+		if limit > p.MaxDelay {
+			limit = p.MaxDelay
+		}
+		if d < 0 || d > limit {
+			t.Errorf("backoff(%d) = %v, want within [0, %v]", attempt, d, limit)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	if d, ok := parseRetryAfter("3"); !ok || d != 3*time.Second {
+		t.Errorf("parseRetryAfter(\"3\") = %v, %v", d, ok)
+	}
+	future := time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat)
+	if d, ok := parseRetryAfter(future); !ok || d <= 0 || d > 10*time.Second {
+		t.Errorf("parseRetryAfter(http-date) = %v, %v", d, ok)
+	}
+	if _, ok := parseRetryAfter("soon"); ok {
+		t.Error("expected invalid Retry-After to be rejected")
+	}
+}
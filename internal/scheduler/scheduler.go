@@ -0,0 +1,96 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+// Job はスケジュールに従って実行される処理です。
+type Job func(ctx context.Context) error
+
+// Schedule は次回の実行時刻を計算します。
+type Schedule interface {
+	Next(t time.Time) time.Time
+}
+
+// Scheduler は cron 式に従って Job を繰り返し実行します。
+// 実行は直列に行われ、Job が失敗 (エラーまたは panic) しても次回の実行は継続されます。
+// 実行中に次の予定時刻を過ぎた場合、その回は実行せずに次の予定時刻を待ちます。
+type Scheduler struct {
+	schedule Schedule
+	location *time.Location
+	job      Job
+
+	// テスト用に差し替え可能な時計
+	now func() time.Time
+}
+
+// Parse は5フィールドの cron 式 (例: "0 9 * * 1-5") または "@daily" などの記述子を解釈します。
+func Parse(spec string) (Schedule, error) {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse cron expression %q: %w", spec, err)
+	}
+	return schedule, nil
+}
+
+// New は cron 式 spec を location のタイムゾーンで解釈する Scheduler を生成します。
+func New(spec string, location *time.Location, job Job) (*Scheduler, error) {
+	schedule, err := Parse(spec)
+	if err != nil {
+		return nil, err
+	}
+	if location == nil {
+		location = time.UTC
+	}
+	return &Scheduler{
+		schedule: schedule,
+		location: location,
+		job:      job,
+		now:      time.Now,
+	}, nil
+}
+
+// Run は ctx がキャンセルされるまで Job をスケジュール実行します。
+// 実行中の Job には同じ ctx が渡されるため、停止要求で実行中の処理もキャンセルされます。
+func (s *Scheduler) Run(ctx context.Context) error {
+	var last time.Time
+	for {
+		next := s.schedule.Next(s.now().In(s.location))
+		if last.IsZero() {
+			log.Printf("INFO: Scheduler started. Next run at %s.", next.Format(time.RFC3339))
+		} else {
+			log.Printf("INFO: Last run at %s. Next run at %s.", last.Format(time.RFC3339), next.Format(time.RFC3339))
+		}
+
+		timer := time.NewTimer(next.Sub(s.now()))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			log.Println("INFO: Scheduler stopped.")
+			return nil
+		case <-timer.C:
+		}
+
+		last = s.now().In(s.location)
+		if err := s.runOnce(ctx); err != nil {
+			log.Printf("ERROR: Scheduled run failed: %v", err)
+		} else {
+			log.Printf("INFO: Scheduled run completed in %s.", s.now().Sub(last).Round(time.Millisecond))
+		}
+	}
+}
+
+// runOnce は Job を1回実行します。panic は回復してエラーとして返します。
+func (s *Scheduler) runOnce(ctx context.Context) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return s.job(ctx)
+}
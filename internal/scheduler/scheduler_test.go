@@ -0,0 +1,126 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// intervalSchedule は一定間隔で実行するテスト用の Schedule です。
+type intervalSchedule time.Duration
+
+func (i intervalSchedule) Next(t time.Time) time.Time {
+	return t.Add(time.Duration(i))
+}
+
+func TestParse(t *testing.T) {
+	t.Run("standard expression in timezone", func(t *testing.T) {
+		schedule, err := Parse("0 9 * * *")
+		if err != nil {
+			t.Fatalf("Parse() returned an error: %v", err)
+		}
+		tokyo := time.FixedZone("JST", 9*60*60)
+		from := time.Date(2026, 10, 18, 10, 0, 0, 0, tokyo)
+		want := time.Date(2026, 10, 19, 9, 0, 0, 0, tokyo)
+		if got := schedule.Next(from); !got.Equal(want) {
+			t.Errorf("expected next run at %s, got %s", want, got)
+		}
+	})
+
+	t.Run("descriptor", func(t *testing.T) {
+		if _, err := Parse("@daily"); err != nil {
+			t.Errorf("Parse() returned an error: %v", err)
+		}
+	})
+
+	t.Run("invalid expression", func(t *testing.T) {
+		if _, err := Parse("every morning"); err == nil {
+			t.Error("expected error for invalid expression")
+		}
+		if _, err := New("61 * * * *", time.UTC, nil); err == nil {
+			t.Error("expected error for out-of-range minute")
+		}
+	})
+}
+
+func TestScheduler_Run(t *testing.T) {
+	t.Run("keeps running after failures and panics", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var calls atomic.Int32
+		s := &Scheduler{
+			schedule: intervalSchedule(10 * time.Millisecond),
+			location: time.UTC,
+			now:      time.Now,
+			job: func(context.Context) error {
+				switch calls.Add(1) {
+				case 1:
+					return errors.New("boom")
+				case 2:
+					panic("unexpected")
+				case 3:
+					cancel()
+				}
+				return nil
+			},
+		}
+
+		done := make(chan error)
+		go func() { done <- s.Run(ctx) }()
+
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("Run() returned an error: %v", err)
+			}
+		case <-time.After(2 * time.Second):
+			t.Fatal("scheduler did not stop")
+		}
+		if got := calls.Load(); got != 3 {
+			t.Errorf("expected 3 runs, got %d", got)
+		}
+	})
+
+	t.Run("stops while waiting for the next run", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		s, err := New("0 0 1 1 *", time.UTC, func(context.Context) error {
+			t.Error("job should not run")
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("New() returned an error: %v", err)
+		}
+
+		done := make(chan error)
+		go func() { done <- s.Run(ctx) }()
+		cancel()
+
+		select {
+		case <-done:
+		case <-time.After(2 * time.Second):
+			t.Fatal("scheduler did not stop")
+		}
+	})
+
+	t.Run("job receives the scheduler context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		s := &Scheduler{
+			schedule: intervalSchedule(time.Millisecond),
+			location: time.UTC,
+			now:      time.Now,
+			job: func(jobCtx context.Context) error {
+				cancel()
+				if jobCtx.Err() == nil {
+					t.Error("expected job context to be canceled with the scheduler")
+				}
+				return nil
+			},
+		}
+		if err := s.Run(ctx); err != nil {
+			t.Errorf("Run() returned an error: %v", err)
+		}
+	})
+}
@@ -0,0 +1,46 @@
+package selector
+
+import (
+	"hash/fnv"
+	"time"
+)
+
+// DeterministicDailySelector は日付と学会から決まるシードで論文を選定するセレクターです。
+// 同じ日に同じ学会で再実行した場合は、候補の並び順に関係なく同じ論文が選ばれます。
+type DeterministicDailySelector struct {
+	seed string
+}
+
+// NewDeterministicDailySelector は新しいDeterministicDailySelectorを生成します。
+func NewDeterministicDailySelector(date time.Time, venue string) *DeterministicDailySelector {
+	return &DeterministicDailySelector{
+		seed: date.Format("2006-01-02") + "|" + venue,
+	}
+}
+
+// Select は必須項目が揃った論文のうち、シードと論文IDのハッシュ値が最大のものを選定します。
+// (Rendezvous hashing により、候補が増減しても他の論文の順位は変わりません)
+func (s *DeterministicDailySelector) Select(papers []Paper) (Paper, error) {
+	candidates := validCandidates(papers)
+	if len(candidates) == 0 {
+		return nil, ErrNoCandidates
+	}
+
+	var selected Paper
+	var maxScore uint64
+	for _, p := range candidates {
+		score := s.score(p.GetID())
+		if selected == nil || score > maxScore || (score == maxScore && p.GetID() < selected.GetID()) {
+			selected, maxScore = p, score
+		}
+	}
+	return selected, nil
+}
+
+func (s *DeterministicDailySelector) score(id string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s.seed))
+	h.Write([]byte{0})
+	h.Write([]byte(id))
+	return h.Sum64()
+}
@@ -0,0 +1,60 @@
+package selector
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestDeterministicDailySelector_Select(t *testing.T) {
+	papers := []Paper{
+		&MockPaper{id: "p1", title: "Title 1"},
+		&MockPaper{id: "p2", title: "Title 2"},
+		&MockPaper{id: "p3", title: "Title 3"},
+		&MockPaper{id: "p4", title: "Title 4"},
+		&MockPaper{id: "p5", title: "Title 5"},
+	}
+	day := time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC)
+
+	t.Run("same day and venue picks same paper regardless of order", func(t *testing.T) {
+		first, err := NewDeterministicDailySelector(day, "ICLR.cc/2025/Conference").Select(papers)
+		if err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+
+		reversed := make([]Paper, len(papers))
+		for i, p := range papers {
+			reversed[len(papers)-1-i] = p
+		}
+		later := day.Add(10 * time.Hour) // 同じ日の別時刻
+		second, err := NewDeterministicDailySelector(later, "ICLR.cc/2025/Conference").Select(reversed)
+		if err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+
+		if first.GetID() != second.GetID() {
+			t.Errorf("expected same paper on rerun, got %s and %s", first.GetID(), second.GetID())
+		}
+	})
+
+	t.Run("different days spread across papers", func(t *testing.T) {
+		seen := map[string]bool{}
+		for i := 0; i < 30; i++ {
+			selected, err := NewDeterministicDailySelector(day.AddDate(0, 0, i), "ICLR.cc/2025/Conference").Select(papers)
+			if err != nil {
+				t.Fatalf("Select() returned an error: %v", err)
+			}
+			seen[selected.GetID()] = true
+		}
+		if len(seen) < 2 {
+			t.Errorf("expected different days to select different papers, got only %v", seen)
+		}
+	})
+
+	t.Run("no candidates because of invalid data", func(t *testing.T) {
+		_, err := NewDeterministicDailySelector(day, "v").Select([]Paper{&MockPaper{id: "", title: "T"}})
+		if !errors.Is(err, ErrNoCandidates) {
+			t.Errorf("expected ErrNoCandidates, but got %v", err)
+		}
+	})
+}
@@ -0,0 +1,36 @@
+package selector
+
+// ExcludingSelector は除外条件に一致する論文を取り除いてから、内側のセレクターに選定を委譲します。
+type ExcludingSelector struct {
+	inner      Selector
+	isExcluded func(paperID string) bool
+}
+
+// NewExcludingSelector は新しいExcludingSelectorを生成します。
+// isExcluded が true を返した論文（例: 投稿済み）は候補から除外されます。
+func NewExcludingSelector(inner Selector, isExcluded func(paperID string) bool) *ExcludingSelector {
+	return &ExcludingSelector{
+		inner:      inner,
+		isExcluded: isExcluded,
+	}
+}
+
+// Select は除外対象を取り除いた論文リストから1本を選定します。
+// 全て除外された場合は ErrNoCandidates を返します。
+func (s *ExcludingSelector) Select(papers []Paper) (Paper, error) {
+	var remaining []Paper
+	for _, p := range papers {
+		if p == nil {
+			continue
+		}
+		if s.isExcluded != nil && s.isExcluded(p.GetID()) {
+			continue
+		}
+		remaining = append(remaining, p)
+	}
+
+	if len(remaining) == 0 {
+		return nil, ErrNoCandidates
+	}
+	return s.inner.Select(remaining)
+}
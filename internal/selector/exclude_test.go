@@ -0,0 +1,43 @@
+package selector
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestExcludingSelector_Select(t *testing.T) {
+	posted := map[string]bool{"p1": true, "p2": true}
+	isPosted := func(id string) bool { return posted[id] }
+
+	t.Run("excluded papers are never selected", func(t *testing.T) {
+		papers := []Paper{
+			&MockPaper{id: "p1", title: "Title 1"},
+			&MockPaper{id: "p2", title: "Title 2"},
+			&MockPaper{id: "p3", title: "Title 3"},
+		}
+		s := NewExcludingSelector(NewRandomSelector(), isPosted)
+
+		for i := 0; i < 20; i++ {
+			selected, err := s.Select(papers)
+			if err != nil {
+				t.Fatalf("Select() returned an error: %v", err)
+			}
+			if selected.GetID() != "p3" {
+				t.Fatalf("expected p3, got %s", selected.GetID())
+			}
+		}
+	})
+
+	t.Run("all papers excluded returns ErrNoCandidates", func(t *testing.T) {
+		papers := []Paper{
+			&MockPaper{id: "p1", title: "Title 1"},
+			&MockPaper{id: "p2", title: "Title 2"},
+		}
+		s := NewExcludingSelector(NewRandomSelector(), isPosted)
+
+		_, err := s.Select(papers)
+		if !errors.Is(err, ErrNoCandidates) {
+			t.Errorf("expected ErrNoCandidates, but got %v", err)
+		}
+	})
+}
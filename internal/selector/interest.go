@@ -0,0 +1,228 @@
+package selector
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// 興味プロファイルで照合するフィールドです。
+const (
+	FieldTitle       = "title"
+	FieldAbstract    = "abstract"
+	FieldKeywords    = "keywords"
+	FieldTLDR        = "tldr"
+	FieldPrimaryArea = "primary_area"
+)
+
+// defaultFieldWeights はフィールドの重みが未指定の場合の値です。
+var defaultFieldWeights = map[string]float64{
+	FieldTitle:       2,
+	FieldAbstract:    1,
+	FieldKeywords:    2,
+	FieldTLDR:        1,
+	FieldPrimaryArea: 1,
+}
+
+// InterestTerm は興味プロファイルの語句です。Keyword と Regex のどちらか一方を指定します。
+type InterestTerm struct {
+	Keyword string  `json:"keyword,omitempty"` // 大文字小文字を区別しない単語単位の一致 ("RL" は "world" に一致しない)
+	Regex   string  `json:"regex,omitempty"`   // 正規表現 (大文字小文字を区別しない部分一致)
+	Weight  float64 `json:"weight,omitempty"`  // Include の語句の重み。未指定なら 1
+}
+
+// InterestProfile は候補の論文を絞り込み、関心度をスコア付けする興味プロファイルです。
+type InterestProfile struct {
+	Include      []InterestTerm     `json:"include,omitempty"`   // 一致したフィールドごとに「語句の重み × フィールドの重み」を加点する
+	Exclude      []InterestTerm     `json:"exclude,omitempty"`   // いずれかのフィールドに一致した論文を除外する
+	FieldWeights map[string]float64 `json:"fields,omitempty"`    // title / abstract / keywords / tldr / primary_area の重み。未指定のフィールドはデフォルト (2 / 1 / 2 / 1 / 1)
+	MinScore     *float64           `json:"min_score,omitempty"` // スコアがこれ未満の論文を除外する。未指定で Include がある場合は、スコア 0 (どの語句にも一致しない) の論文を除外する
+	TopK         int                `json:"top_k,omitempty"`     // 0 より大きい場合、スコア上位 TopK 件だけを次の段に渡す
+}
+
+// compiledTerm はコンパイル済みの語句です。
+type compiledTerm struct {
+	re     *regexp.Regexp
+	weight float64
+}
+
+// InterestSelector は興味プロファイルで候補を絞り込み、関心度の高い論文だけを内側のセレクターに渡すセレクターです。
+type InterestSelector struct {
+	inner        Selector
+	include      []compiledTerm
+	exclude      []compiledTerm
+	fieldWeights map[string]float64
+	minScore     float64
+	requireMatch bool // Include のいずれかの語句に一致した (スコアが 0 より大きい) 論文だけを残す
+	topK         int
+}
+
+// NewInterestSelector は新しいInterestSelectorを生成します。プロファイルが不正な場合はエラーを返します。
+func NewInterestSelector(inner Selector, profile InterestProfile) (*InterestSelector, error) {
+	include, err := compileTerms(profile.Include)
+	if err != nil {
+		return nil, fmt.Errorf("invalid include term: %w", err)
+	}
+	exclude, err := compileTerms(profile.Exclude)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exclude term: %w", err)
+	}
+
+	fieldWeights := map[string]float64{}
+	for field, w := range defaultFieldWeights {
+		fieldWeights[field] = w
+	}
+	for field, w := range profile.FieldWeights {
+		if _, ok := defaultFieldWeights[field]; !ok {
+			return nil, fmt.Errorf("unknown field %q. must be one of %s, %s, %s, %s, %s", field, FieldTitle, FieldAbstract, FieldKeywords, FieldTLDR, FieldPrimaryArea)
+		}
+		if w < 0 {
+			return nil, fmt.Errorf("invalid weight %v for field %s. must not be negative", w, field)
+		}
+		fieldWeights[field] = w
+	}
+	if profile.TopK < 0 {
+		return nil, fmt.Errorf("invalid top_k %d. must not be negative", profile.TopK)
+	}
+
+	s := &InterestSelector{
+		inner:        inner,
+		include:      include,
+		exclude:      exclude,
+		fieldWeights: fieldWeights,
+		requireMatch: len(include) > 0, // 語句を並べただけのプロファイルも絞り込みとして働くようにする
+		topK:         profile.TopK,
+	}
+	if profile.MinScore != nil {
+		s.minScore, s.requireMatch = *profile.MinScore, false
+	}
+	return s, nil
+}
+
+// keyword の前後に付ける単語境界です。前後にラテン文字・数字・アンダースコアが続く場合は一致しません。
+// \b と異なり "révision" の "é" のような ASCII 以外のラテン文字も単語の一部として扱います。
+const (
+	keywordPrefix = `(?:^|[^\p{Latin}\p{N}_])`
+	keywordSuffix = `(?:[^\p{Latin}\p{N}_]|$)`
+)
+
+// keywordPattern は keyword を単語単位で一致させる正規表現を返します ("RL" は "world" に一致しない)。
+// 日本語のように単語を空白で区切らない文字が端にある場合は、その端を部分一致のままにします。
+func keywordPattern(keyword string) string {
+	pattern := regexp.QuoteMeta(keyword)
+	if first, _ := utf8.DecodeRuneInString(keyword); needsBoundary(first) {
+		pattern = keywordPrefix + pattern
+	}
+	if last, _ := utf8.DecodeLastRuneInString(keyword); needsBoundary(last) {
+		pattern += keywordSuffix
+	}
+	return pattern
+}
+
+// needsBoundary は keyword の端の文字 r の外側に単語境界を求めるかを返します。
+// ラテン文字・数字・記号の場合は求め、それ以外の文字 (かな・漢字など) の場合は求めません。
+func needsBoundary(r rune) bool {
+	return !unicode.IsLetter(r) || unicode.Is(unicode.Latin, r)
+}
+
+// compileTerms は語句を大文字小文字を区別しない正規表現にコンパイルします。
+// keyword は単語単位、regex はそのままの正規表現 (部分一致) として扱います。
+func compileTerms(terms []InterestTerm) ([]compiledTerm, error) {
+	compiled := make([]compiledTerm, 0, len(terms))
+	for _, t := range terms {
+		var pattern string
+		switch {
+		case t.Keyword != "" && t.Regex != "":
+			return nil, fmt.Errorf("keyword %q and regex %q are both set", t.Keyword, t.Regex)
+		case t.Keyword != "":
+			pattern = keywordPattern(t.Keyword)
+		case t.Regex != "":
+			pattern = t.Regex
+		default:
+			return nil, fmt.Errorf("keyword or regex is required")
+		}
+		re, err := regexp.Compile("(?i)" + pattern)
+		if err != nil {
+			return nil, err
+		}
+		if t.Weight < 0 {
+			return nil, fmt.Errorf("invalid weight %v for %q. must not be negative", t.Weight, pattern)
+		}
+		weight := t.Weight
+		if weight == 0 {
+			weight = 1
+		}
+		compiled = append(compiled, compiledTerm{re: re, weight: weight})
+	}
+	return compiled, nil
+}
+
+// Select は除外語句に一致する論文と MinScore 未満 (未指定なら Include のどの語句にも一致しない) の論文を取り除き、スコア上位 TopK 件から内側のセレクターで1本を選定します。
+// 全て取り除かれた場合は ErrNoCandidates を返します。
+func (s *InterestSelector) Select(papers []Paper) (Paper, error) {
+	type scored struct {
+		paper Paper
+		score float64
+	}
+	var remaining []scored
+	for _, p := range validCandidates(papers) {
+		fields := paperFields(p)
+		if s.excluded(fields) {
+			continue
+		}
+		if score := s.score(fields); score >= s.minScore && (score > 0 || !s.requireMatch) {
+			remaining = append(remaining, scored{paper: p, score: score})
+		}
+	}
+	if len(remaining) == 0 {
+		return nil, ErrNoCandidates
+	}
+
+	if s.topK > 0 && len(remaining) > s.topK {
+		sort.SliceStable(remaining, func(i, j int) bool { return remaining[i].score > remaining[j].score })
+		remaining = remaining[:s.topK]
+	}
+	candidates := make([]Paper, len(remaining))
+	for i, r := range remaining {
+		candidates[i] = r.paper
+	}
+	return s.inner.Select(candidates)
+}
+
+func (s *InterestSelector) excluded(fields map[string]string) bool {
+	for _, t := range s.exclude {
+		for _, text := range fields {
+			if t.re.MatchString(text) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *InterestSelector) score(fields map[string]string) float64 {
+	var score float64
+	for _, t := range s.include {
+		for field, text := range fields {
+			if t.re.MatchString(text) {
+				score += t.weight * s.fieldWeights[field]
+			}
+		}
+	}
+	return score
+}
+
+// paperFields は論文の照合対象のフィールドを返します。Described を実装しない論文はタイトルのみです。
+func paperFields(p Paper) map[string]string {
+	fields := map[string]string{FieldTitle: p.GetTitle()}
+	if d, ok := p.(Described); ok {
+		fields[FieldAbstract] = d.GetAbstract()
+		fields[FieldKeywords] = strings.Join(d.GetKeywords(), "\n")
+		fields[FieldTLDR] = d.GetTLDR()
+		fields[FieldPrimaryArea] = d.GetPrimaryArea()
+	}
+	return fields
+}
@@ -0,0 +1,242 @@
+package selector
+
+import (
+	"errors"
+	"testing"
+)
+
+// describedPaper は Described を実装するテスト用の論文です。
+type describedPaper struct {
+	MockPaper
+	abstract    string
+	keywords    []string
+	tldr        string
+	primaryArea string
+}
+
+func (p *describedPaper) GetAbstract() string    { return p.abstract }
+func (p *describedPaper) GetKeywords() []string  { return p.keywords }
+func (p *describedPaper) GetTLDR() string        { return p.tldr }
+func (p *describedPaper) GetPrimaryArea() string { return p.primaryArea }
+
+func dp(id, title, abstract string, keywords ...string) *describedPaper {
+	return &describedPaper{MockPaper: MockPaper{id: id, title: title}, abstract: abstract, keywords: keywords}
+}
+
+// recordingSelector は渡された候補を記録し、先頭を返すテスト用のセレクターです。
+type recordingSelector struct {
+	got []Paper
+}
+
+func (s *recordingSelector) Select(papers []Paper) (Paper, error) {
+	s.got = papers
+	return papers[0], nil
+}
+
+func minScore(v float64) *float64 { return &v }
+
+func ids(papers []Paper) []string {
+	out := make([]string, len(papers))
+	for i, p := range papers {
+		out[i] = p.GetID()
+	}
+	return out
+}
+
+func TestInterestSelector_Select(t *testing.T) {
+	papers := []Paper{
+		dp("rl", "Offline Reinforcement Learning at Scale", "We study offline RL.", "reinforcement learning"),
+		dp("vision", "A Vision Transformer", "Image classification with attention.", "computer vision"),
+		dp("nlp", "Language Models Are Few-Shot Learners", "We train a large language model."),
+		dp("survey", "A Survey of Reinforcement Learning", "We survey RL methods."),
+		&MockPaper{id: "title-only", title: "Robust RL in the Wild"},
+	}
+
+	profile := InterestProfile{
+		Include: []InterestTerm{
+			{Keyword: "reinforcement learning", Weight: 2},
+			{Regex: `\bRL\b`},
+			{Keyword: "vision"},
+		},
+		Exclude:  []InterestTerm{{Keyword: "survey"}},
+		MinScore: minScore(1),
+	}
+
+	t.Run("excludes and filters by min score", func(t *testing.T) {
+		inner := &recordingSelector{}
+		s, err := NewInterestSelector(inner, profile)
+		if err != nil {
+			t.Fatalf("NewInterestSelector() failed: %v", err)
+		}
+		if _, err := s.Select(papers); err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+		got := ids(inner.got)
+		want := []string{"rl", "vision", "title-only"}
+		if len(got) != len(want) {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Errorf("expected %v, got %v", want, got)
+				break
+			}
+		}
+	})
+
+	t.Run("top_k keeps the highest scores", func(t *testing.T) {
+		inner := &recordingSelector{}
+		p := profile
+		p.TopK = 1
+		s, err := NewInterestSelector(inner, p)
+		if err != nil {
+			t.Fatalf("NewInterestSelector() failed: %v", err)
+		}
+		if _, err := s.Select(papers); err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+		if got := ids(inner.got); len(got) != 1 || got[0] != "rl" {
+			t.Errorf("expected [rl], got %v", got)
+		}
+	})
+
+	t.Run("field weights change the score", func(t *testing.T) {
+		p := InterestProfile{
+			Include:      []InterestTerm{{Keyword: "attention"}},
+			FieldWeights: map[string]float64{FieldAbstract: 0},
+			MinScore:     minScore(0.5),
+		}
+		s, err := NewInterestSelector(&recordingSelector{}, p)
+		if err != nil {
+			t.Fatalf("NewInterestSelector() failed: %v", err)
+		}
+		if _, err := s.Select(papers); !errors.Is(err, ErrNoCandidates) {
+			t.Errorf("expected ErrNoCandidates when only the ignored abstract matches, got %v", err)
+		}
+	})
+
+	t.Run("matches tldr and primary area", func(t *testing.T) {
+		inner := &recordingSelector{}
+		area := dp("area", "Untitled", "")
+		area.primaryArea = "reinforcement learning"
+		tldr := dp("tldr", "Untitled", "")
+		tldr.tldr = "A new vision backbone."
+		s, err := NewInterestSelector(inner, InterestProfile{
+			Include:      []InterestTerm{{Keyword: "reinforcement learning"}, {Keyword: "vision"}},
+			FieldWeights: map[string]float64{FieldTLDR: 3},
+			MinScore:     minScore(1),
+			TopK:         1,
+		})
+		if err != nil {
+			t.Fatalf("NewInterestSelector() failed: %v", err)
+		}
+		if _, err := s.Select([]Paper{area, tldr}); err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+		if got := ids(inner.got); len(got) != 1 || got[0] != "tldr" {
+			t.Errorf("expected the tldr match (weight 3) to rank first, got %v", got)
+		}
+	})
+
+	t.Run("include terms without min_score keep only matches", func(t *testing.T) {
+		inner := &recordingSelector{}
+		s, err := NewInterestSelector(inner, InterestProfile{Include: []InterestTerm{{Keyword: "vision"}}})
+		if err != nil {
+			t.Fatalf("NewInterestSelector() failed: %v", err)
+		}
+		if _, err := s.Select(papers); err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+		if got := ids(inner.got); len(got) != 1 || got[0] != "vision" {
+			t.Errorf("expected [vision], got %v", got)
+		}
+	})
+
+	t.Run("explicit min_score 0 keeps unmatched candidates", func(t *testing.T) {
+		inner := &recordingSelector{}
+		s, err := NewInterestSelector(inner, InterestProfile{Include: []InterestTerm{{Keyword: "vision"}}, MinScore: minScore(0), TopK: 2})
+		if err != nil {
+			t.Fatalf("NewInterestSelector() failed: %v", err)
+		}
+		if _, err := s.Select(papers); err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+		if got := ids(inner.got); len(got) != 2 || got[0] != "vision" {
+			t.Errorf("expected vision first and one unmatched paper, got %v", got)
+		}
+	})
+
+	t.Run("no profile terms keeps every candidate", func(t *testing.T) {
+		inner := &recordingSelector{}
+		s, err := NewInterestSelector(inner, InterestProfile{})
+		if err != nil {
+			t.Fatalf("NewInterestSelector() failed: %v", err)
+		}
+		if _, err := s.Select(papers); err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+		if len(inner.got) != len(papers) {
+			t.Errorf("expected %d candidates, got %d", len(papers), len(inner.got))
+		}
+	})
+}
+
+func TestNewInterestSelector_InvalidProfile(t *testing.T) {
+	tests := map[string]InterestProfile{
+		"invalid regex":        {Include: []InterestTerm{{Regex: "("}}},
+		"empty term":           {Exclude: []InterestTerm{{}}},
+		"keyword and regex":    {Include: []InterestTerm{{Keyword: "rl", Regex: "rl"}}},
+		"negative term weight": {Include: []InterestTerm{{Keyword: "rl", Weight: -1}}},
+		"unknown field":        {FieldWeights: map[string]float64{"venue": 1}},
+		"negative top_k":       {TopK: -1},
+	}
+	for name, profile := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := NewInterestSelector(NewRandomSelector(), profile); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestKeywordPattern(t *testing.T) {
+	tests := []struct {
+		keyword string
+		text    string
+		want    bool
+	}{
+		{"RL", "Offline RL with diffusion", true},
+		{"RL", "rl-based agents", true},
+		{"RL", "Hello world", false},
+		{"RL", "A robust controller", false},
+		{"vision", "Vision transformers", true},
+		{"vision", "Self-supervision at scale", false},
+		{"vision", "A révision of benchmarks", false},
+		{"reinforcement learning", "Deep Reinforcement Learning.", true},
+		{"C++", "Fast C++ kernels", true},
+		{"C++", "C++x is not C", false},
+		{"強化学習", "深層強化学習による制御", true},
+		{"RL", "RLによる制御", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.keyword+" in "+tt.text, func(t *testing.T) {
+			terms, err := compileTerms([]InterestTerm{{Keyword: tt.keyword}})
+			if err != nil {
+				t.Fatalf("compileTerms() failed: %v", err)
+			}
+			if got := terms[0].re.MatchString(tt.text); got != tt.want {
+				t.Errorf("keyword %q matching %q = %v, want %v", tt.keyword, tt.text, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("regex keeps substring matching", func(t *testing.T) {
+		terms, err := compileTerms([]InterestTerm{{Regex: "rl"}})
+		if err != nil {
+			t.Fatalf("compileTerms() failed: %v", err)
+		}
+		if !terms[0].re.MatchString("Hello world") {
+			t.Error("expected raw regex to match as a substring")
+		}
+	})
+}
@@ -0,0 +1,34 @@
+package selector
+
+// NewestSelector は作成日時 (CDate) が最も新しい論文を選定するセレクターです。
+type NewestSelector struct{}
+
+// NewNewestSelector は新しいNewestSelectorを生成します。
+func NewNewestSelector() *NewestSelector {
+	return &NewestSelector{}
+}
+
+// Select は必須項目が揃った論文のうち、CDate が最大のものを選定します。
+// Dated を実装しない論文は最も古いものとして扱い、同値の場合は先に現れたものを優先します。
+func (s *NewestSelector) Select(papers []Paper) (Paper, error) {
+	candidates := validCandidates(papers)
+	if len(candidates) == 0 {
+		return nil, ErrNoCandidates
+	}
+
+	newest := candidates[0]
+	newestDate := cdateOf(newest)
+	for _, p := range candidates[1:] {
+		if d := cdateOf(p); d > newestDate {
+			newest, newestDate = p, d
+		}
+	}
+	return newest, nil
+}
+
+func cdateOf(p Paper) int64 {
+	if d, ok := p.(Dated); ok {
+		return d.GetCDate()
+	}
+	return 0
+}
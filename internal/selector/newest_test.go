@@ -0,0 +1,57 @@
+package selector
+
+import (
+	"errors"
+	"testing"
+)
+
+// datedMockPaper は作成日時を持つテスト用のPaper実装です。
+type datedMockPaper struct {
+	MockPaper
+	cdate int64
+}
+
+func (m *datedMockPaper) GetCDate() int64 {
+	return m.cdate
+}
+
+func TestNewestSelector_Select(t *testing.T) {
+	t.Run("selects paper with largest CDate", func(t *testing.T) {
+		papers := []Paper{
+			&datedMockPaper{MockPaper{id: "p1", title: "Title 1"}, 100},
+			&datedMockPaper{MockPaper{id: "p2", title: "Title 2"}, 300},
+			&datedMockPaper{MockPaper{id: "p3", title: ""}, 999}, // Invalid title
+			&datedMockPaper{MockPaper{id: "p4", title: "Title 4"}, 200},
+		}
+
+		selected, err := NewNewestSelector().Select(papers)
+		if err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+		if selected.GetID() != "p2" {
+			t.Errorf("expected p2, got %s", selected.GetID())
+		}
+	})
+
+	t.Run("papers without CDate are treated as oldest", func(t *testing.T) {
+		papers := []Paper{
+			&MockPaper{id: "p1", title: "Title 1"},
+			&datedMockPaper{MockPaper{id: "p2", title: "Title 2"}, 1},
+		}
+
+		selected, err := NewNewestSelector().Select(papers)
+		if err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+		if selected.GetID() != "p2" {
+			t.Errorf("expected p2, got %s", selected.GetID())
+		}
+	})
+
+	t.Run("no papers provided", func(t *testing.T) {
+		_, err := NewNewestSelector().Select(nil)
+		if !errors.Is(err, ErrNoCandidates) {
+			t.Errorf("expected ErrNoCandidates, but got %v", err)
+		}
+	})
+}
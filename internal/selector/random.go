@@ -23,19 +23,7 @@ func NewRandomSelector() *RandomSelector {
 
 // Select は論文リストから必須項目が揃ったものをフィルタリングし、ランダムに1本を選定します。
 func (s *RandomSelector) Select(papers []Paper) (Paper, error) {
-	var candidates []Paper
-
-	// 必須項目をチェックします。
-	for _, p := range papers {
-		if p == nil {
-			continue
-		}
-		if p.GetID() == "" || p.GetTitle() == "" {
-			continue // データ不整合はスキップ
-		}
-		candidates = append(candidates, p)
-	}
-
+	candidates := validCandidates(papers)
 	if len(candidates) == 0 {
 		return nil, ErrNoCandidates
 	}
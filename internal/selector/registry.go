@@ -0,0 +1,61 @@
+package selector
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+var ErrUnknownStrategy = errors.New("unknown select strategy")
+
+// Options はセレクター生成時に渡される実行時の情報です。
+type Options struct {
+	Date        time.Time // 実行日
+	Venue       string    // 選定された学会の Venue ID
+	Temperature float64   // score の温度
+}
+
+// Factory は Options からセレクターを生成する関数です。
+type Factory func(opts Options) Selector
+
+// registry は SELECT_STRATEGY の値とセレクターの対応表です。
+var registry = map[string]Factory{
+	"random": func(Options) Selector {
+		return NewRandomSelector()
+	},
+	"newest": func(Options) Selector {
+		return NewNewestSelector()
+	},
+	"deterministic-daily": func(opts Options) Selector {
+		return NewDeterministicDailySelector(opts.Date, opts.Venue)
+	},
+	"score": func(opts Options) Selector {
+		return NewScoreSelector(opts.Temperature)
+	},
+}
+
+// New は戦略名に対応するセレクターを生成します。
+func New(strategy string, opts Options) (Selector, error) {
+	factory, ok := registry[strategy]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q (available: %v)", ErrUnknownStrategy, strategy, Strategies())
+	}
+	return factory(opts), nil
+}
+
+// IsRegistered は戦略名が登録済みかどうかを返します。
+func IsRegistered(strategy string) bool {
+	_, ok := registry[strategy]
+	return ok
+}
+
+// Strategies は登録済みの戦略名をソートして返します。
+func Strategies() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,33 @@
+package selector
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	for _, name := range []string{"random", "newest", "deterministic-daily", "score"} {
+		t.Run(name, func(t *testing.T) {
+			s, err := New(name, Options{Venue: "ICLR.cc/2025/Conference"})
+			if err != nil {
+				t.Fatalf("New(%q) returned an error: %v", name, err)
+			}
+			if s == nil {
+				t.Fatalf("New(%q) returned nil selector", name)
+			}
+			if !IsRegistered(name) {
+				t.Errorf("expected %q to be registered", name)
+			}
+		})
+	}
+
+	t.Run("unknown strategy", func(t *testing.T) {
+		_, err := New("best-paper", Options{})
+		if !errors.Is(err, ErrUnknownStrategy) {
+			t.Errorf("expected ErrUnknownStrategy, but got %v", err)
+		}
+		if IsRegistered("best-paper") {
+			t.Error("expected best-paper not to be registered")
+		}
+	})
+}
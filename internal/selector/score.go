@@ -0,0 +1,119 @@
+package selector
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+// DefaultTemperature は ScoreSelector のデフォルトの温度です。
+const DefaultTemperature = 1.0
+
+// decisionTiers は採択区分と、スコアに加えるボーナスです。先頭から順に判定します。
+var decisionTiers = []struct {
+	keywords []string
+	bonus    float64
+}{
+	{keywords: []string{"oral", "notable-top-5%"}, bonus: 2},
+	{keywords: []string{"spotlight", "notable-top-25%"}, bonus: 1},
+}
+
+// ScoreSelector は査読の平均評価と採択区分 (oral > spotlight > poster) から論文のスコアを計算し、
+// スコアの softmax に比例した確率で論文を選定するセレクターです。
+// 温度が低いほど高スコアの論文に偏り、0 の場合は常に最高スコアの論文を選びます。高いほど一様なランダムに近づきます。
+type ScoreSelector struct {
+	temperature float64
+	rand        *rand.Rand
+}
+
+// NewScoreSelector は新しいScoreSelectorを生成します。temperature が負の場合は 0 として扱います。
+func NewScoreSelector(temperature float64) *ScoreSelector {
+	return &ScoreSelector{
+		temperature: math.Max(temperature, 0),
+		rand:        rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Select は必須項目が揃った論文をスコアに応じて1本選定します。
+// スコアは「平均評価 + 採択区分のボーナス (oral +2, spotlight +1)」です。
+// 評価のない論文には、候補の平均評価の平均 (評価のある論文がなければ 0) を用います。
+func (s *ScoreSelector) Select(papers []Paper) (Paper, error) {
+	candidates := validCandidates(papers)
+	if len(candidates) == 0 {
+		return nil, ErrNoCandidates
+	}
+
+	scores := paperScores(candidates)
+	best := 0
+	for i := range candidates {
+		if scores[i] > scores[best] || (scores[i] == scores[best] && candidates[i].GetID() < candidates[best].GetID()) {
+			best = i
+		}
+	}
+	if s.temperature == 0 {
+		return candidates[best], nil
+	}
+
+	// 最高スコアとの差で指数を取り、オーバーフローを防ぐ
+	weights := make([]float64, len(candidates))
+	var total float64
+	for i, score := range scores {
+		weights[i] = math.Exp((score - scores[best]) / s.temperature)
+		total += weights[i]
+	}
+	x := s.rand.Float64() * total
+	for i, w := range weights {
+		if x < w {
+			return candidates[i], nil
+		}
+		x -= w
+	}
+	return candidates[best], nil // 浮動小数点の誤差で末尾を越えた場合
+}
+
+// paperScores は各論文のスコアを計算します。
+func paperScores(papers []Paper) []float64 {
+	ratings := make([]float64, len(papers))
+	rated := make([]bool, len(papers))
+	var sum float64
+	var n int
+	for i, p := range papers {
+		if r, ok := p.(Reviewed); ok {
+			ratings[i], rated[i] = r.GetMeanRating()
+		}
+		if rated[i] {
+			sum += ratings[i]
+			n++
+		}
+	}
+	var fallback float64
+	if n > 0 {
+		fallback = sum / float64(n)
+	}
+
+	scores := make([]float64, len(papers))
+	for i, p := range papers {
+		scores[i] = fallback
+		if rated[i] {
+			scores[i] = ratings[i]
+		}
+		if r, ok := p.(Reviewed); ok {
+			scores[i] += decisionBonus(r.GetDecision())
+		}
+	}
+	return scores
+}
+
+// decisionBonus は採否の文字列から採択区分のボーナスを返します。
+func decisionBonus(decision string) float64 {
+	decision = strings.ToLower(decision)
+	for _, tier := range decisionTiers {
+		for _, kw := range tier.keywords {
+			if strings.Contains(decision, kw) {
+				return tier.bonus
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,96 @@
+package selector
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+// reviewedPaper は Reviewed を実装するテスト用の論文です。
+type reviewedPaper struct {
+	MockPaper
+	rating   float64
+	rated    bool
+	decision string
+}
+
+func (p *reviewedPaper) GetMeanRating() (float64, bool) { return p.rating, p.rated }
+func (p *reviewedPaper) GetDecision() string            { return p.decision }
+
+func rp(id string, rating float64, decision string) *reviewedPaper {
+	return &reviewedPaper{MockPaper: MockPaper{id: id, title: "Title " + id}, rating: rating, rated: true, decision: decision}
+}
+
+func TestScoreSelector_Select(t *testing.T) {
+	papers := []Paper{
+		rp("poster", 6, "Accept (Poster)"),
+		rp("oral", 6, "Accept (Oral)"),
+		rp("high", 7.5, "ICLR 2025 Poster"),
+		&MockPaper{id: "unreviewed", title: "No reviews"},
+	}
+
+	t.Run("temperature 0 picks the highest score", func(t *testing.T) {
+		selected, err := NewScoreSelector(0).Select(papers)
+		if err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+		if selected.GetID() != "oral" {
+			t.Errorf("expected oral (6 + 2), got %s", selected.GetID())
+		}
+	})
+
+	t.Run("higher scores are picked more often", func(t *testing.T) {
+		s := NewScoreSelector(1)
+		s.rand = rand.New(rand.NewSource(1))
+		counts := map[string]int{}
+		for range 2000 {
+			selected, err := s.Select(papers)
+			if err != nil {
+				t.Fatalf("Select() returned an error: %v", err)
+			}
+			counts[selected.GetID()]++
+		}
+		if counts["oral"] <= counts["high"] || counts["high"] <= counts["poster"] {
+			t.Errorf("expected oral > high > poster, got %v", counts)
+		}
+		if counts["poster"] == 0 {
+			t.Errorf("expected lower scored papers to be picked sometimes, got %v", counts)
+		}
+	})
+
+	t.Run("high temperature is close to uniform", func(t *testing.T) {
+		s := NewScoreSelector(1000)
+		s.rand = rand.New(rand.NewSource(1))
+		counts := map[string]int{}
+		for range 4000 {
+			selected, _ := s.Select(papers)
+			counts[selected.GetID()]++
+		}
+		for id, n := range counts {
+			if n < 800 || n > 1200 {
+				t.Errorf("expected about 1000 picks for %s, got %d", id, n)
+			}
+		}
+	})
+
+	t.Run("no valid candidates", func(t *testing.T) {
+		_, err := NewScoreSelector(1).Select([]Paper{&MockPaper{id: "p1"}})
+		if !errors.Is(err, ErrNoCandidates) {
+			t.Errorf("expected ErrNoCandidates, got %v", err)
+		}
+	})
+}
+
+func TestPaperScores(t *testing.T) {
+	scores := paperScores([]Paper{
+		rp("a", 8, "Accept (Spotlight)"),
+		rp("b", 4, ""),
+		&MockPaper{id: "c", title: "unreviewed"},
+	})
+	want := []float64{9, 4, 6} // 評価のない論文は平均評価 (8+4)/2
+	for i := range want {
+		if scores[i] != want[i] {
+			t.Errorf("score %d: expected %v, got %v", i, want[i], scores[i])
+		}
+	}
+}
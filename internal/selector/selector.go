@@ -1,7 +1,7 @@
 package selector
 
 // Paper は selector が要求する論文のインターフェースです。
-// 外部の具体的な論文構造体（例: openreview.Note）は、このインターフェースを実装する必要があります。
+// 外部の具体的な論文構造体（例: paper.Paper）は、このインターフェースを実装する必要があります。
 type Paper interface {
 	GetID() string
 	GetTitle() string
@@ -11,3 +11,37 @@ type Paper interface {
 type Selector interface {
 	Select(papers []Paper) (Paper, error)
 }
+
+// Dated は作成日時を持つ論文が任意で実装するインターフェースです。
+type Dated interface {
+	GetCDate() int64 // 作成日時 (Unix ミリ秒)
+}
+
+// Reviewed は査読結果を持つ論文が任意で実装するインターフェースです。
+type Reviewed interface {
+	GetMeanRating() (float64, bool) // 平均評価スコア。スコアがない場合は false
+	GetDecision() string            // 採否・採択区分 (例: "Accept (Oral)", "ICLR 2025 Spotlight")
+}
+
+// Described は本文情報を持つ論文が任意で実装するインターフェースです。
+type Described interface {
+	GetAbstract() string
+	GetKeywords() []string
+	GetTLDR() string
+	GetPrimaryArea() string
+}
+
+// validCandidates は必須項目 (ID / タイトル) が揃った論文のみを返します。
+func validCandidates(papers []Paper) []Paper {
+	var candidates []Paper
+	for _, p := range papers {
+		if p == nil {
+			continue
+		}
+		if p.GetID() == "" || p.GetTitle() == "" {
+			continue // データ不整合はスキップ
+		}
+		candidates = append(candidates, p)
+	}
+	return candidates
+}
@@ -0,0 +1,68 @@
+package selector
+
+import (
+	"cmp"
+	"math/rand"
+	"slices"
+	"time"
+)
+
+// DefaultShortlistSize は ShortlistSelector が内側のセレクターに渡す候補数のデフォルト値です。
+const DefaultShortlistSize = 30
+
+// ShortlistSelector は候補を size 件に絞り込み、enrich で情報 (査読結果など) を補ってから内側のセレクターに選定を委譲します。
+// 候補全件の査読を取得せずに score で選定するために使います。
+type ShortlistSelector struct {
+	inner  Selector
+	size   int
+	enrich func(Paper) error
+	rand   *rand.Rand
+}
+
+// NewShortlistSelector は新しいShortlistSelectorを生成します。size が 0 以下の場合は DefaultShortlistSize を使います。
+// enrich は絞り込んだ候補ごとに呼び出され、エラーを返すと選定を中断します。
+func NewShortlistSelector(inner Selector, size int, enrich func(Paper) error) *ShortlistSelector {
+	if size <= 0 {
+		size = DefaultShortlistSize
+	}
+	return &ShortlistSelector{
+		inner:  inner,
+		size:   size,
+		enrich: enrich,
+		rand:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Select は採択区分のボーナス (oral > spotlight > その他) が高い順に size 件を選び、enrich を呼んでから内側のセレクターに渡します。
+// ボーナスが同じ論文の中からはランダムに選びます。
+func (s *ShortlistSelector) Select(papers []Paper) (Paper, error) {
+	candidates := validCandidates(papers)
+	if len(candidates) == 0 {
+		return nil, ErrNoCandidates
+	}
+
+	s.rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	slices.SortStableFunc(candidates, func(a, b Paper) int {
+		return cmp.Compare(paperDecisionBonus(b), paperDecisionBonus(a))
+	})
+	shortlist := candidates[:min(s.size, len(candidates))]
+
+	if s.enrich != nil {
+		for _, p := range shortlist {
+			if err := s.enrich(p); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s.inner.Select(shortlist)
+}
+
+// paperDecisionBonus は論文の採否から採択区分のボーナスを返します。Reviewed を実装しない論文は 0 です。
+func paperDecisionBonus(p Paper) float64 {
+	if r, ok := p.(Reviewed); ok {
+		return decisionBonus(r.GetDecision())
+	}
+	return 0
+}
@@ -0,0 +1,58 @@
+package selector
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestShortlistSelector_Select(t *testing.T) {
+	var papers []Paper
+	for i := 0; i < 10; i++ {
+		papers = append(papers, rp(fmt.Sprintf("poster%d", i), 0, "ICLR 2025 Poster"))
+	}
+	papers = append(papers, rp("oral", 0, "ICLR 2025 Oral"), rp("spotlight", 0, "ICLR 2025 Spotlight"))
+
+	t.Run("enriches only the shortlist and prefers better decisions", func(t *testing.T) {
+		var enriched []string
+		var passed []Paper
+		inner := selectorFunc(func(papers []Paper) (Paper, error) {
+			passed = papers
+			return papers[0], nil
+		})
+		s := NewShortlistSelector(inner, 3, func(p Paper) error {
+			enriched = append(enriched, p.GetID())
+			return nil
+		})
+
+		if _, err := s.Select(papers); err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+		if len(passed) != 3 || len(enriched) != 3 {
+			t.Fatalf("expected 3 papers to be enriched and passed, got %d / %d", len(enriched), len(passed))
+		}
+		if passed[0].GetID() != "oral" || passed[1].GetID() != "spotlight" {
+			t.Errorf("expected oral and spotlight first, got %s, %s", passed[0].GetID(), passed[1].GetID())
+		}
+	})
+
+	t.Run("enrich error aborts selection", func(t *testing.T) {
+		wantErr := errors.New("aborted")
+		s := NewShortlistSelector(NewRandomSelector(), 3, func(Paper) error { return wantErr })
+		if _, err := s.Select(papers); !errors.Is(err, wantErr) {
+			t.Errorf("expected enrich error, got %v", err)
+		}
+	})
+
+	t.Run("no candidates", func(t *testing.T) {
+		s := NewShortlistSelector(NewRandomSelector(), 3, nil)
+		if _, err := s.Select(nil); !errors.Is(err, ErrNoCandidates) {
+			t.Errorf("expected ErrNoCandidates, got %v", err)
+		}
+	})
+}
+
+// selectorFunc は関数を Selector として使うためのテスト用の型です。
+type selectorFunc func([]Paper) (Paper, error)
+
+func (f selectorFunc) Select(papers []Paper) (Paper, error) { return f(papers) }
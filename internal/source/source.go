@@ -0,0 +1,19 @@
+// Package source は論文の取得元 (OpenReview, arXiv など) を共通のインターフェースで扱います。
+package source
+
+import (
+	"context"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+)
+
+// Source は論文の取得元です。取得した論文は取得元によらず paper.Paper に変換して返します。
+type Source interface {
+	// ListPapers は学会設定 (または検索条件) に該当する論文の一覧を取得します。
+	ListPapers(ctx context.Context, venue config.VenueConfig) ([]*paper.Paper, error)
+	// CountPapers は ListPapers の対象となる論文の件数を返します。
+	CountPapers(ctx context.Context, venue config.VenueConfig) (int, error)
+	// GetPaper は ID を指定して論文を1件取得します。
+	GetPaper(ctx context.Context, id string) (*paper.Paper, error)
+}
@@ -0,0 +1,14 @@
+package source
+
+import (
+	"github.com/hayashi-yaken/daily-paper-bot/internal/arxiv"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/openreview"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/readinglist"
+)
+
+// 各クライアントが Source を実装していることをコンパイル時に確認する
+var (
+	_ Source = (*openreview.Client)(nil)
+	_ Source = (*arxiv.Client)(nil)
+	_ Source = (*readinglist.Source)(nil)
+)
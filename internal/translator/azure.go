@@ -0,0 +1,65 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
+)
+
+type azureTranslator struct {
+	httpClient *http.Client
+	endpoint   string
+	region     string
+	key        string
+	retry      retry.Policy
+}
+
+// NewAzureTranslator は Azure AI Translator v3.0 を叩く Translator を返します。
+func NewAzureTranslator(endpoint, region, key string, retryPolicy retry.Policy) Translator {
+	return &azureTranslator{
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		endpoint:   endpoint,
+		region:     region,
+		key:        key,
+		retry:      retryPolicy,
+	}
+}
+
+type translateRequestItem struct {
+	Text string `json:"Text"`
+}
+
+type translateResponseItem struct {
+	Translations []struct {
+		Text string `json:"text"`
+		To   string `json:"to"`
+	} `json:"translations"`
+}
+
+func (t *azureTranslator) Translate(ctx context.Context, text, targetLang string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	q := url.Values{}
+	q.Set("api-version", "3.0")
+	q.Set("to", targetLang)
+	reqURL := t.endpoint + "/translate?" + q.Encode()
+
+	header := http.Header{}
+	header.Set("Ocp-Apim-Subscription-Key", t.key)
+	header.Set("Ocp-Apim-Subscription-Region", t.region)
+
+	var items []translateResponseItem
+	body, err := postJSON(ctx, t.httpClient, t.retry, reqURL, header, []translateRequestItem{{Text: text}}, &items)
+	if err != nil {
+		return "", err
+	}
+	if len(items) == 0 || len(items[0].Translations) == 0 || items[0].Translations[0].Text == "" {
+		return "", fmt.Errorf("translator response missing translation: %s", string(body))
+	}
+	return items[0].Translations[0].Text, nil
+}
@@ -0,0 +1,116 @@
+package translator
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"sync"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/atomicfile"
+)
+
+// cacheFile は翻訳キャッシュ (data/translations.json) のトップレベル構造です。
+type cacheFile struct {
+	Translations map[string]cacheEntry `json:"translations"`
+}
+
+// cacheEntry は翻訳1件分のキャッシュです。
+type cacheEntry struct {
+	Lang string `json:"lang"` // 翻訳先の言語
+	Text string `json:"text"` // 翻訳結果
+}
+
+// CachedTranslator は翻訳結果を JSON ファイルにキャッシュする Translator です。
+// 原文と翻訳先の言語のハッシュをキーにするため、同じ Abstract を再び翻訳する (同じ論文の再実行やリトライ) 場合は API を呼びません。
+type CachedTranslator struct {
+	next Translator
+	path string
+
+	mu      sync.Mutex
+	loaded  bool
+	entries map[string]cacheEntry
+}
+
+// NewCachedTranslator は next の翻訳結果を path の JSON ファイルにキャッシュする CachedTranslator を生成します。
+// キャッシュは最初の翻訳時に読み込みます。
+func NewCachedTranslator(next Translator, path string) *CachedTranslator {
+	return &CachedTranslator{
+		next:    next,
+		path:    path,
+		entries: map[string]cacheEntry{},
+	}
+}
+
+// Translate はキャッシュにある翻訳を返し、なければ next で翻訳してキャッシュに書き出します。
+// キャッシュの読み書きに失敗しても翻訳は続行します (WARN ログのみ)。
+func (c *CachedTranslator) Translate(ctx context.Context, text, targetLang string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !c.loaded {
+		if err := c.load(); err != nil {
+			log.Printf("WARN: ignoring translation cache: %v", err)
+		}
+		c.loaded = true
+	}
+
+	key := cacheKey(text, targetLang)
+	if e, ok := c.entries[key]; ok {
+		log.Printf("INFO: Using cached translation (%s).", key[:12])
+		return e.Text, nil
+	}
+
+	translated, err := c.next.Translate(ctx, text, targetLang)
+	if err != nil {
+		return "", err
+	}
+	c.entries[key] = cacheEntry{Lang: targetLang, Text: translated}
+	if err := c.save(); err != nil {
+		log.Printf("WARN: failed to save translation cache: %v", err)
+	}
+	return translated, nil
+}
+
+// cacheKey は翻訳先の言語と原文の SHA-256 を16進数で返します。
+func cacheKey(text, targetLang string) string {
+	sum := sha256.Sum256([]byte(targetLang + "\x00" + text))
+	return hex.EncodeToString(sum[:])
+}
+
+// load は JSON ファイルからキャッシュを読み込みます。ファイルが存在しない場合は空のキャッシュとして扱います。
+func (c *CachedTranslator) load() error {
+	bytes, err := os.ReadFile(c.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read translation cache at %s: %w", c.path, err)
+	}
+
+	var file cacheFile
+	if err := json.Unmarshal(bytes, &file); err != nil {
+		return fmt.Errorf("failed to parse translation cache: %w", err)
+	}
+	if file.Translations != nil {
+		c.entries = file.Translations
+	}
+	return nil
+}
+
+// save は一時ファイルに書き出してからリネームし、書き込み途中のファイルが残らないようにします。
+func (c *CachedTranslator) save() error {
+	if err := atomicfile.WriteJSON(c.path, cacheFile{Translations: c.entries}); err != nil {
+		return fmt.Errorf("failed to save translation cache: %w", err)
+	}
+	return nil
+}
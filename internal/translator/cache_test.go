@@ -0,0 +1,82 @@
+package translator
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCachedTranslator_Translate(t *testing.T) {
+	t.Run("identical text is translated once across instances", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "data", "translations.json")
+		stub := &stubTranslator{}
+
+		first := NewCachedTranslator(stub, path)
+		if _, err := first.Translate(context.Background(), "hello", "ja"); err != nil {
+			t.Fatalf("Translate failed: %v", err)
+		}
+
+		// 別の実行 (新しいインスタンス) でもファイルのキャッシュを使う
+		second := NewCachedTranslator(stub, path)
+		got, err := second.Translate(context.Background(), "hello", "ja")
+		if err != nil {
+			t.Fatalf("Translate failed: %v", err)
+		}
+		if got != "ja:hello" {
+			t.Errorf("expected cached 'ja:hello', got %q", got)
+		}
+		if len(stub.calls) != 1 {
+			t.Errorf("expected one translation request, got %v", stub.calls)
+		}
+	})
+
+	t.Run("different text or language is translated", func(t *testing.T) {
+		stub := &stubTranslator{}
+		tr := NewCachedTranslator(stub, filepath.Join(t.TempDir(), "translations.json"))
+		for _, tc := range []struct{ text, lang string }{{"hello", "ja"}, {"world", "ja"}, {"hello", "ko"}, {"hello", "ja"}} {
+			if _, err := tr.Translate(context.Background(), tc.text, tc.lang); err != nil {
+				t.Fatalf("Translate failed: %v", err)
+			}
+		}
+		if len(stub.calls) != 3 {
+			t.Errorf("expected three translation requests, got %v", stub.calls)
+		}
+	})
+
+	t.Run("failures are not cached", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "translations.json")
+		stub := &stubTranslator{err: errors.New("unavailable")}
+		tr := NewCachedTranslator(stub, path)
+		if _, err := tr.Translate(context.Background(), "hello", "ja"); err == nil {
+			t.Fatal("expected error from the wrapped translator")
+		}
+		stub.err = nil
+		if got, err := tr.Translate(context.Background(), "hello", "ja"); err != nil || got != "ja:hello" {
+			t.Errorf("expected retry to translate, got %q (err=%v)", got, err)
+		}
+		if len(stub.calls) != 2 {
+			t.Errorf("expected two translation requests, got %v", stub.calls)
+		}
+	})
+
+	t.Run("broken cache file does not block translation", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "translations.json")
+		if err := os.WriteFile(path, []byte(`{"translations":`), 0644); err != nil {
+			t.Fatalf("failed to write fixture: %v", err)
+		}
+		tr := NewCachedTranslator(&stubTranslator{}, path)
+		if got, err := tr.Translate(context.Background(), "hello", "ja"); err != nil || got != "ja:hello" {
+			t.Errorf("expected translation despite broken cache, got %q (err=%v)", got, err)
+		}
+		// 翻訳後は正しいキャッシュで上書きされる
+		stub := &stubTranslator{}
+		if _, err := NewCachedTranslator(stub, path).Translate(context.Background(), "hello", "ja"); err != nil {
+			t.Fatalf("Translate failed: %v", err)
+		}
+		if len(stub.calls) != 0 {
+			t.Errorf("expected rewritten cache to be used, got %v", stub.calls)
+		}
+	})
+}
@@ -0,0 +1,49 @@
+package translator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+)
+
+// Provider は名前付きの Translator です。名前はフォールバック時のログとエラーに使います。
+type Provider struct {
+	Name       string
+	Translator Translator
+}
+
+type chainTranslator struct {
+	providers []Provider
+}
+
+// NewChainTranslator は providers を順に試し、最初に成功した翻訳を返す Translator を返します。
+// 失敗した翻訳サービスは WARN ログを出して次を試し、全て失敗した場合はそれぞれのエラーをまとめて返します。
+func NewChainTranslator(providers ...Provider) Translator {
+	return &chainTranslator{providers: providers}
+}
+
+func (t *chainTranslator) Translate(ctx context.Context, text, targetLang string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	var errs []error
+	for i, p := range t.providers {
+		translated, err := p.Translator.Translate(ctx, text, targetLang)
+		if err == nil {
+			return translated, nil
+		}
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", p.Name, err))
+		if i+1 < len(t.providers) {
+			log.Printf("WARN: translator %s failed, trying %s: %v", p.Name, t.providers[i+1].Name, err)
+		}
+	}
+	if len(errs) == 0 {
+		return "", errors.New("no translator configured")
+	}
+	return "", fmt.Errorf("all translators failed: %w", errors.Join(errs...))
+}
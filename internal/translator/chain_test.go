@@ -0,0 +1,67 @@
+package translator
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestChainTranslator_Translate(t *testing.T) {
+	t.Run("first success is returned", func(t *testing.T) {
+		first, second := &stubTranslator{}, &stubTranslator{}
+		tr := NewChainTranslator(Provider{"deepl", first}, Provider{"azure", second})
+		got, err := tr.Translate(context.Background(), "hello", "ja")
+		if err != nil {
+			t.Fatalf("Translate failed: %v", err)
+		}
+		if got != "ja:hello" {
+			t.Errorf("expected 'ja:hello', got %q", got)
+		}
+		if len(second.calls) != 0 {
+			t.Errorf("expected second translator not to be called, got %v", second.calls)
+		}
+	})
+
+	t.Run("falls back to the next provider", func(t *testing.T) {
+		first := &stubTranslator{err: errors.New("quota exceeded")}
+		second := &stubTranslator{}
+		tr := NewChainTranslator(Provider{"deepl", first}, Provider{"azure", second})
+		got, err := tr.Translate(context.Background(), "hello", "ja")
+		if err != nil {
+			t.Fatalf("Translate failed: %v", err)
+		}
+		if got != "ja:hello" || len(first.calls) != 1 || len(second.calls) != 1 {
+			t.Errorf("expected fallback translation, got %q (calls: %v, %v)", got, first.calls, second.calls)
+		}
+	})
+
+	t.Run("all failures are reported", func(t *testing.T) {
+		tr := NewChainTranslator(
+			Provider{"deepl", &stubTranslator{err: errors.New("quota exceeded")}},
+			Provider{"azure", &stubTranslator{err: errors.New("invalid key")}},
+		)
+		_, err := tr.Translate(context.Background(), "hello", "ja")
+		if err == nil {
+			t.Fatal("expected error when every translator fails")
+		}
+		for _, want := range []string{"deepl: quota exceeded", "azure: invalid key"} {
+			if !strings.Contains(err.Error(), want) {
+				t.Errorf("expected error to contain %q, got %v", want, err)
+			}
+		}
+	})
+
+	t.Run("canceled context stops the chain", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		second := &stubTranslator{}
+		tr := NewChainTranslator(Provider{"deepl", &stubTranslator{err: context.Canceled}}, Provider{"azure", second})
+		if _, err := tr.Translate(ctx, "hello", "ja"); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+		if len(second.calls) != 0 {
+			t.Errorf("expected second translator not to be called, got %v", second.calls)
+		}
+	})
+}
@@ -0,0 +1,61 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
+)
+
+type deeplTranslator struct {
+	httpClient *http.Client
+	endpoint   string
+	key        string
+	retry      retry.Policy
+}
+
+// NewDeepLTranslator は DeepL API (v2) を叩く Translator を返します。
+// endpoint は Free プランなら "https://api-free.deepl.com"、Pro プランなら "https://api.deepl.com" です。
+func NewDeepLTranslator(endpoint, key string, retryPolicy retry.Policy) Translator {
+	return &deeplTranslator{
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		endpoint:   strings.TrimRight(endpoint, "/"),
+		key:        key,
+		retry:      retryPolicy,
+	}
+}
+
+type deeplRequest struct {
+	Text       []string `json:"text"`
+	TargetLang string   `json:"target_lang"`
+}
+
+type deeplResponse struct {
+	Translations []struct {
+		DetectedSourceLanguage string `json:"detected_source_language"`
+		Text                   string `json:"text"`
+	} `json:"translations"`
+}
+
+func (t *deeplTranslator) Translate(ctx context.Context, text, targetLang string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	header := http.Header{}
+	header.Set("Authorization", "DeepL-Auth-Key "+t.key)
+
+	// DeepL の言語コードは大文字 (例: "JA", "EN-US")
+	payload := deeplRequest{Text: []string{text}, TargetLang: strings.ToUpper(targetLang)}
+	var res deeplResponse
+	body, err := postJSON(ctx, t.httpClient, t.retry, t.endpoint+"/v2/translate", header, payload, &res)
+	if err != nil {
+		return "", err
+	}
+	if len(res.Translations) == 0 || res.Translations[0].Text == "" {
+		return "", fmt.Errorf("translator response missing translation: %s", string(body))
+	}
+	return res.Translations[0].Text, nil
+}
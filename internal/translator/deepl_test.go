@@ -0,0 +1,70 @@
+package translator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
+)
+
+func TestDeepLTranslator_Translate(t *testing.T) {
+	t.Run("success returns translation", func(t *testing.T) {
+		var receivedAuth, receivedPath string
+		var received deeplRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedAuth = r.Header.Get("Authorization")
+			receivedPath = r.URL.Path
+			_ = json.NewDecoder(r.Body).Decode(&received)
+			_, _ = w.Write([]byte(`{"translations":[{"detected_source_language":"EN","text":"こんにちは"}]}`))
+		}))
+		defer server.Close()
+
+		tr := NewDeepLTranslator(server.URL+"/", "secret-key:fx", retry.NoRetry())
+		got, err := tr.Translate(context.Background(), "hello", "ja")
+		if err != nil {
+			t.Fatalf("Translate failed: %v", err)
+		}
+		if got != "こんにちは" {
+			t.Errorf("expected translation 'こんにちは', got %q", got)
+		}
+		if receivedAuth != "DeepL-Auth-Key secret-key:fx" {
+			t.Errorf("expected DeepL-Auth-Key authorization, got %q", receivedAuth)
+		}
+		if receivedPath != "/v2/translate" {
+			t.Errorf("expected path /v2/translate, got %q", receivedPath)
+		}
+		if len(received.Text) != 1 || received.Text[0] != "hello" || received.TargetLang != "JA" {
+			t.Errorf("expected body {text:[hello], target_lang:JA}, got %+v", received)
+		}
+	})
+
+	t.Run("non-2xx returns error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(`{"message":"Wrong endpoint"}`))
+		}))
+		defer server.Close()
+
+		tr := NewDeepLTranslator(server.URL, "k", retry.NoRetry())
+		_, err := tr.Translate(context.Background(), "hello", "ja")
+		if err == nil || !strings.Contains(err.Error(), "403") {
+			t.Errorf("expected error mentioning status 403, got %v", err)
+		}
+	})
+
+	t.Run("empty translations returns error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"translations":[]}`))
+		}))
+		defer server.Close()
+
+		tr := NewDeepLTranslator(server.URL, "k", retry.NoRetry())
+		if _, err := tr.Translate(context.Background(), "hello", "ja"); err == nil {
+			t.Fatal("expected error for empty translations")
+		}
+	})
+}
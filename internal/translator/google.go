@@ -0,0 +1,64 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
+)
+
+type googleTranslator struct {
+	httpClient *http.Client
+	endpoint   string
+	key        string
+	retry      retry.Policy
+}
+
+// NewGoogleTranslator は Google Cloud Translation API (Basic, v2) を叩く Translator を返します。
+// 認証には API キーを使います。キーは URL ではなく X-Goog-Api-Key ヘッダーで送り、通信エラーのメッセージに含まれないようにします。
+func NewGoogleTranslator(endpoint, key string, retryPolicy retry.Policy) Translator {
+	return &googleTranslator{
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		endpoint:   strings.TrimRight(endpoint, "/"),
+		key:        key,
+		retry:      retryPolicy,
+	}
+}
+
+type googleRequest struct {
+	Q      []string `json:"q"`
+	Target string   `json:"target"`
+	Format string   `json:"format"`
+}
+
+type googleResponse struct {
+	Data struct {
+		Translations []struct {
+			TranslatedText         string `json:"translatedText"`
+			DetectedSourceLanguage string `json:"detectedSourceLanguage"`
+		} `json:"translations"`
+	} `json:"data"`
+}
+
+func (t *googleTranslator) Translate(ctx context.Context, text, targetLang string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	header := http.Header{}
+	header.Set("X-Goog-Api-Key", t.key)
+
+	// format=text を指定しないと、訳文の記号が HTML エスケープされる
+	payload := googleRequest{Q: []string{text}, Target: targetLang, Format: "text"}
+	var res googleResponse
+	body, err := postJSON(ctx, t.httpClient, t.retry, t.endpoint+"/language/translate/v2", header, payload, &res)
+	if err != nil {
+		return "", err
+	}
+	if len(res.Data.Translations) == 0 || res.Data.Translations[0].TranslatedText == "" {
+		return "", fmt.Errorf("translator response missing translation: %s", string(body))
+	}
+	return res.Data.Translations[0].TranslatedText, nil
+}
@@ -0,0 +1,88 @@
+package translator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
+)
+
+func TestGoogleTranslator_Translate(t *testing.T) {
+	t.Run("success returns translation", func(t *testing.T) {
+		var receivedPath, receivedQuery, receivedKey string
+		var received googleRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedPath = r.URL.Path
+			receivedQuery = r.URL.RawQuery
+			receivedKey = r.Header.Get("X-Goog-Api-Key")
+			_ = json.NewDecoder(r.Body).Decode(&received)
+			_, _ = w.Write([]byte(`{"data":{"translations":[{"translatedText":"A & B を比較する","detectedSourceLanguage":"en"}]}}`))
+		}))
+		defer server.Close()
+
+		tr := NewGoogleTranslator(server.URL, "secret-key", retry.NoRetry())
+		got, err := tr.Translate(context.Background(), "compare A & B", "ja")
+		if err != nil {
+			t.Fatalf("Translate failed: %v", err)
+		}
+		if got != "A & B を比較する" {
+			t.Errorf("expected translation 'A & B を比較する', got %q", got)
+		}
+		if receivedPath != "/language/translate/v2" {
+			t.Errorf("expected path /language/translate/v2, got %q", receivedPath)
+		}
+		if receivedKey != "secret-key" {
+			t.Errorf("expected X-Goog-Api-Key: secret-key, got %q", receivedKey)
+		}
+		if strings.Contains(receivedQuery, "secret-key") {
+			t.Errorf("expected API key not to be sent in the URL, got query %q", receivedQuery)
+		}
+		if len(received.Q) != 1 || received.Q[0] != "compare A & B" || received.Target != "ja" || received.Format != "text" {
+			t.Errorf("expected body {q:[compare A & B], target:ja, format:text}, got %+v", received)
+		}
+	})
+
+	t.Run("non-2xx returns error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":{"code":400,"message":"API key not valid."}}`))
+		}))
+		defer server.Close()
+
+		tr := NewGoogleTranslator(server.URL, "k", retry.NoRetry())
+		_, err := tr.Translate(context.Background(), "hello", "ja")
+		if err == nil || !strings.Contains(err.Error(), "400") {
+			t.Errorf("expected error mentioning status 400, got %v", err)
+		}
+	})
+
+	t.Run("transport error does not leak the key", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		server.Close() // 接続できないエンドポイント
+
+		tr := NewGoogleTranslator(server.URL, "secret-key", retry.NoRetry())
+		_, err := tr.Translate(context.Background(), "hello", "ja")
+		if err == nil {
+			t.Fatal("expected error for unreachable endpoint")
+		}
+		if strings.Contains(err.Error(), "secret-key") {
+			t.Errorf("expected error without the API key, got %v", err)
+		}
+	})
+
+	t.Run("missing data returns error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{}`))
+		}))
+		defer server.Close()
+
+		tr := NewGoogleTranslator(server.URL, "k", retry.NoRetry())
+		if _, err := tr.Translate(context.Background(), "hello", "ja"); err == nil {
+			t.Fatal("expected error for response without translations")
+		}
+	})
+}
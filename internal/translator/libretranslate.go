@@ -0,0 +1,57 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
+)
+
+type libreTranslator struct {
+	httpClient *http.Client
+	endpoint   string
+	key        string
+	retry      retry.Policy
+}
+
+// NewLibreTranslator は LibreTranslate の /translate API を叩く Translator を返します。
+// key はインスタンスが API キーを要求する場合のみ指定します (空なら送信しません)。
+func NewLibreTranslator(endpoint, key string, retryPolicy retry.Policy) Translator {
+	return &libreTranslator{
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		endpoint:   strings.TrimRight(endpoint, "/"),
+		key:        key,
+		retry:      retryPolicy,
+	}
+}
+
+type libreRequest struct {
+	Q      string `json:"q"`
+	Source string `json:"source"`
+	Target string `json:"target"`
+	Format string `json:"format"`
+	APIKey string `json:"api_key,omitempty"`
+}
+
+type libreResponse struct {
+	TranslatedText string `json:"translatedText"`
+}
+
+func (t *libreTranslator) Translate(ctx context.Context, text, targetLang string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	payload := libreRequest{Q: text, Source: "auto", Target: targetLang, Format: "text", APIKey: t.key}
+	var res libreResponse
+	body, err := postJSON(ctx, t.httpClient, t.retry, t.endpoint+"/translate", nil, payload, &res)
+	if err != nil {
+		return "", err
+	}
+	if res.TranslatedText == "" {
+		return "", fmt.Errorf("translator response missing translation: %s", string(body))
+	}
+	return res.TranslatedText, nil
+}
@@ -0,0 +1,74 @@
+package translator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
+)
+
+func TestLibreTranslator_Translate(t *testing.T) {
+	t.Run("success returns translation", func(t *testing.T) {
+		var receivedPath string
+		var received map[string]string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedPath = r.URL.Path
+			_ = json.NewDecoder(r.Body).Decode(&received)
+			_, _ = w.Write([]byte(`{"translatedText":"こんにちは"}`))
+		}))
+		defer server.Close()
+
+		tr := NewLibreTranslator(server.URL, "secret-key", retry.NoRetry())
+		got, err := tr.Translate(context.Background(), "hello", "ja")
+		if err != nil {
+			t.Fatalf("Translate failed: %v", err)
+		}
+		if got != "こんにちは" {
+			t.Errorf("expected translation 'こんにちは', got %q", got)
+		}
+		if receivedPath != "/translate" {
+			t.Errorf("expected path /translate, got %q", receivedPath)
+		}
+		want := map[string]string{"q": "hello", "source": "auto", "target": "ja", "format": "text", "api_key": "secret-key"}
+		for k, v := range want {
+			if received[k] != v {
+				t.Errorf("expected %s=%q in body, got %q", k, v, received[k])
+			}
+		}
+	})
+
+	t.Run("api key is omitted when empty", func(t *testing.T) {
+		var received map[string]string
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewDecoder(r.Body).Decode(&received)
+			_, _ = w.Write([]byte(`{"translatedText":"こんにちは"}`))
+		}))
+		defer server.Close()
+
+		tr := NewLibreTranslator(server.URL, "", retry.NoRetry())
+		if _, err := tr.Translate(context.Background(), "hello", "ja"); err != nil {
+			t.Fatalf("Translate failed: %v", err)
+		}
+		if _, ok := received["api_key"]; ok {
+			t.Errorf("expected api_key to be omitted, got %+v", received)
+		}
+	})
+
+	t.Run("non-2xx returns error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"ja is not supported"}`))
+		}))
+		defer server.Close()
+
+		tr := NewLibreTranslator(server.URL, "", retry.NoRetry())
+		_, err := tr.Translate(context.Background(), "hello", "ja")
+		if err == nil || !strings.Contains(err.Error(), "not supported") {
+			t.Errorf("expected error containing the response body, got %v", err)
+		}
+	})
+}
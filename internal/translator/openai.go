@@ -0,0 +1,94 @@
+package translator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
+)
+
+// languageNames は翻訳指示のプロンプトに使う言語名です。含まれない言語はコードのまま指示します。
+var languageNames = map[string]string{
+	"ja": "Japanese",
+	"en": "English",
+	"zh": "Chinese",
+	"ko": "Korean",
+}
+
+type openAITranslator struct {
+	httpClient *http.Client
+	baseURL    string
+	key        string
+	model      string
+	retry      retry.Policy
+}
+
+// NewOpenAITranslator は OpenAI 互換の Chat Completions API (POST {baseURL}/chat/completions) で翻訳する Translator を返します。
+// baseURL を変えることで、互換 API を提供する他のサービスやローカルのサーバーも使えます。key が空の場合は Authorization ヘッダーを送りません。
+func NewOpenAITranslator(baseURL, key, model string, retryPolicy retry.Policy) Translator {
+	return &openAITranslator{
+		httpClient: &http.Client{Timeout: 2 * defaultTimeout}, // 生成は機械翻訳 API より時間がかかる
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		key:        key,
+		model:      model,
+		retry:      retryPolicy,
+	}
+}
+
+type chatMessage struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type chatRequest struct {
+	Model       string        `json:"model"`
+	Messages    []chatMessage `json:"messages"`
+	Temperature float64       `json:"temperature"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message chatMessage `json:"message"`
+	} `json:"choices"`
+}
+
+func (t *openAITranslator) Translate(ctx context.Context, text, targetLang string) (string, error) {
+	if text == "" {
+		return "", nil
+	}
+
+	header := http.Header{}
+	if t.key != "" {
+		header.Set("Authorization", "Bearer "+t.key)
+	}
+
+	payload := chatRequest{
+		Model: t.model,
+		Messages: []chatMessage{
+			{Role: "system", Content: translationPrompt(targetLang)},
+			{Role: "user", Content: text},
+		},
+		Temperature: 0,
+	}
+	var res chatResponse
+	body, err := postJSON(ctx, t.httpClient, t.retry, t.baseURL+"/chat/completions", header, payload, &res)
+	if err != nil {
+		return "", err
+	}
+	if len(res.Choices) == 0 || strings.TrimSpace(res.Choices[0].Message.Content) == "" {
+		return "", fmt.Errorf("translator response missing translation: %s", string(body))
+	}
+	return strings.TrimSpace(res.Choices[0].Message.Content), nil
+}
+
+// translationPrompt は targetLang への翻訳を指示するシステムプロンプトを返します。
+func translationPrompt(targetLang string) string {
+	lang, ok := languageNames[strings.ToLower(targetLang)]
+	if !ok {
+		lang = fmt.Sprintf("the language with code %q", targetLang)
+	}
+	return fmt.Sprintf("You are a professional translator of academic papers. Translate the user's text into %s. "+
+		"Keep technical terms, model names, math and citations accurate. Output only the translation, without any notes or quotation marks.", lang)
+}
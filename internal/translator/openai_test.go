@@ -0,0 +1,102 @@
+package translator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
+)
+
+func TestOpenAITranslator_Translate(t *testing.T) {
+	t.Run("success returns translation", func(t *testing.T) {
+		var receivedAuth, receivedPath string
+		var received chatRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedAuth = r.Header.Get("Authorization")
+			receivedPath = r.URL.Path
+			_ = json.NewDecoder(r.Body).Decode(&received)
+			_, _ = w.Write([]byte(`{"choices":[{"index":0,"message":{"role":"assistant","content":"こんにちは\n"}}]}`))
+		}))
+		defer server.Close()
+
+		tr := NewOpenAITranslator(server.URL+"/v1", "secret-key", "test-model", retry.NoRetry())
+		got, err := tr.Translate(context.Background(), "hello", "ja")
+		if err != nil {
+			t.Fatalf("Translate failed: %v", err)
+		}
+		if got != "こんにちは" {
+			t.Errorf("expected trimmed translation 'こんにちは', got %q", got)
+		}
+		if receivedAuth != "Bearer secret-key" {
+			t.Errorf("expected bearer authorization, got %q", receivedAuth)
+		}
+		if receivedPath != "/v1/chat/completions" {
+			t.Errorf("expected path /v1/chat/completions, got %q", receivedPath)
+		}
+		if received.Model != "test-model" || len(received.Messages) != 2 {
+			t.Fatalf("unexpected request body: %+v", received)
+		}
+		if !strings.Contains(received.Messages[0].Content, "Japanese") {
+			t.Errorf("expected system prompt to name the target language, got %q", received.Messages[0].Content)
+		}
+		if received.Messages[1].Role != "user" || received.Messages[1].Content != "hello" {
+			t.Errorf("expected user message 'hello', got %+v", received.Messages[1])
+		}
+	})
+
+	t.Run("authorization is omitted without key", func(t *testing.T) {
+		receivedAuth := "unset"
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedAuth = r.Header.Get("Authorization")
+			_, _ = w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"こんにちは"}}]}`))
+		}))
+		defer server.Close()
+
+		tr := NewOpenAITranslator(server.URL, "", "local-model", retry.NoRetry())
+		if _, err := tr.Translate(context.Background(), "hello", "ja"); err != nil {
+			t.Fatalf("Translate failed: %v", err)
+		}
+		if receivedAuth != "" {
+			t.Errorf("expected no Authorization header, got %q", receivedAuth)
+		}
+	})
+
+	t.Run("empty choices returns error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"choices":[]}`))
+		}))
+		defer server.Close()
+
+		tr := NewOpenAITranslator(server.URL, "k", "m", retry.NoRetry())
+		if _, err := tr.Translate(context.Background(), "hello", "ja"); err == nil {
+			t.Fatal("expected error for empty choices")
+		}
+	})
+
+	t.Run("non-2xx returns error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"message":"Incorrect API key provided"}}`))
+		}))
+		defer server.Close()
+
+		tr := NewOpenAITranslator(server.URL, "k", "m", retry.NoRetry())
+		_, err := tr.Translate(context.Background(), "hello", "ja")
+		if err == nil || !strings.Contains(err.Error(), "401") {
+			t.Errorf("expected error mentioning status 401, got %v", err)
+		}
+	})
+}
+
+func TestTranslationPrompt(t *testing.T) {
+	if got := translationPrompt("JA"); !strings.Contains(got, "Japanese") {
+		t.Errorf("expected prompt for JA to mention Japanese, got %q", got)
+	}
+	if got := translationPrompt("fr"); !strings.Contains(got, `"fr"`) {
+		t.Errorf("expected prompt for unknown language to include the code, got %q", got)
+	}
+}
@@ -2,91 +2,70 @@ package translator
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"net/url"
+	"strings"
 	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
 )
 
 // Translator は文字列を指定言語に翻訳します。
 type Translator interface {
-	Translate(text, targetLang string) (string, error)
-}
-
-type azureTranslator struct {
-	httpClient *http.Client
-	endpoint   string
-	region     string
-	key        string
+	Translate(ctx context.Context, text, targetLang string) (string, error)
 }
 
-// NewAzureTranslator は Azure AI Translator v3.0 を叩く Translator を返します。
-func NewAzureTranslator(endpoint, region, key string) Translator {
-	return &azureTranslator{
-		httpClient: &http.Client{Timeout: 30 * time.Second},
-		endpoint:   endpoint,
-		region:     region,
-		key:        key,
+// TranslateAbstract は論文の Abstract を targetLang に翻訳します。Abstract が空の場合は翻訳せずに空文字を返します。
+func TranslateAbstract(ctx context.Context, t Translator, p *paper.Paper, targetLang string) (string, error) {
+	if strings.TrimSpace(p.Abstract) == "" {
+		return "", nil
 	}
+	return t.Translate(ctx, p.Abstract, targetLang)
 }
 
-type translateRequestItem struct {
-	Text string `json:"Text"`
-}
-
-type translateResponseItem struct {
-	Translations []struct {
-		Text string `json:"text"`
-		To   string `json:"to"`
-	} `json:"translations"`
-}
+// defaultTimeout は翻訳 API へのリクエストのタイムアウトです。
+const defaultTimeout = 30 * time.Second
 
-func (t *azureTranslator) Translate(text, targetLang string) (string, error) {
-	if text == "" {
-		return "", nil
-	}
-
-	payload, err := json.Marshal([]translateRequestItem{{Text: text}})
+// postJSON は payload を JSON として reqURL に POST し、2xx のレスポンスを out にデコードします。
+// 翻訳は副作用がないため、POST でもリトライポリシーに従って再送します。
+// デコードに失敗した場合の診断用に、レスポンスボディも返します。
+func postJSON(ctx context.Context, httpClient *http.Client, retryPolicy retry.Policy, reqURL string, header http.Header, payload, out any) ([]byte, error) {
+	data, err := json.Marshal(payload)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal translator request: %w", err)
+		return nil, fmt.Errorf("failed to marshal translator request: %w", err)
 	}
 
-	q := url.Values{}
-	q.Set("api-version", "3.0")
-	q.Set("to", targetLang)
-	reqURL := t.endpoint + "/translate?" + q.Encode()
-
-	req, err := http.NewRequest(http.MethodPost, reqURL, bytes.NewReader(payload))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewReader(data))
 	if err != nil {
-		return "", fmt.Errorf("failed to create translator request: %w", err)
+		return nil, fmt.Errorf("failed to create translator request: %w", err)
+	}
+	for name, values := range header {
+		req.Header[name] = values
 	}
 	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Ocp-Apim-Subscription-Key", t.key)
-	req.Header.Set("Ocp-Apim-Subscription-Region", t.region)
+	retry.MarkIdempotent(req)
 
-	resp, err := t.httpClient.Do(req)
+	resp, err := retryPolicy.Do(httpClient, req)
 	if err != nil {
-		return "", fmt.Errorf("failed to execute translator request: %w", err)
+		return nil, fmt.Errorf("failed to execute translator request: %w", err)
 	}
 	defer resp.Body.Close()
 
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return "", fmt.Errorf("failed to read translator response: %w", err)
+		return nil, fmt.Errorf("failed to read translator response: %w", err)
 	}
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-		return "", fmt.Errorf("translator returned status %d: %s", resp.StatusCode, string(body))
+		return body, fmt.Errorf("translator returned status %d: %s", resp.StatusCode, string(body))
 	}
 
-	var items []translateResponseItem
-	if err := json.Unmarshal(body, &items); err != nil {
-		return "", fmt.Errorf("failed to decode translator response: %w", err)
-	}
-	if len(items) == 0 || len(items[0].Translations) == 0 || items[0].Translations[0].Text == "" {
-		return "", fmt.Errorf("translator response missing translation: %s", string(body))
+	if err := json.Unmarshal(body, out); err != nil {
+		return body, fmt.Errorf("failed to decode translator response: %w", err)
 	}
-	return items[0].Translations[0].Text, nil
+	return body, nil
 }
@@ -1,12 +1,17 @@
 package translator
 
 import (
+	"context"
 	"encoding/json"
 	"io"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/paper"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/retry"
 )
 
 func TestAzureTranslator_Translate(t *testing.T) {
@@ -23,8 +28,8 @@ func TestAzureTranslator_Translate(t *testing.T) {
 		}))
 		defer server.Close()
 
-		tr := NewAzureTranslator(server.URL, "japaneast", "secret-key")
-		got, err := tr.Translate("hello", "ja")
+		tr := NewAzureTranslator(server.URL, "japaneast", "secret-key", retry.NoRetry())
+		got, err := tr.Translate(context.Background(), "hello", "ja")
 		if err != nil {
 			t.Fatalf("Translate failed: %v", err)
 		}
@@ -57,8 +62,8 @@ func TestAzureTranslator_Translate(t *testing.T) {
 		}))
 		defer server.Close()
 
-		tr := NewAzureTranslator(server.URL, "japaneast", "k")
-		got, err := tr.Translate("", "ja")
+		tr := NewAzureTranslator(server.URL, "japaneast", "k", retry.NoRetry())
+		got, err := tr.Translate(context.Background(), "", "ja")
 		if err != nil {
 			t.Fatalf("Translate returned error for empty input: %v", err)
 		}
@@ -77,8 +82,8 @@ func TestAzureTranslator_Translate(t *testing.T) {
 		}))
 		defer server.Close()
 
-		tr := NewAzureTranslator(server.URL, "japaneast", "k")
-		_, err := tr.Translate("hello", "ja")
+		tr := NewAzureTranslator(server.URL, "japaneast", "k", retry.NoRetry())
+		_, err := tr.Translate(context.Background(), "hello", "ja")
 		if err == nil {
 			t.Fatal("expected error for 401 response")
 		}
@@ -93,8 +98,8 @@ func TestAzureTranslator_Translate(t *testing.T) {
 		}))
 		defer server.Close()
 
-		tr := NewAzureTranslator(server.URL, "japaneast", "k")
-		_, err := tr.Translate("hello", "ja")
+		tr := NewAzureTranslator(server.URL, "japaneast", "k", retry.NoRetry())
+		_, err := tr.Translate(context.Background(), "hello", "ja")
 		if err == nil {
 			t.Fatal("expected error for malformed JSON")
 		}
@@ -106,21 +111,85 @@ func TestAzureTranslator_Translate(t *testing.T) {
 		}))
 		defer server.Close()
 
-		tr := NewAzureTranslator(server.URL, "japaneast", "k")
-		_, err := tr.Translate("hello", "ja")
+		tr := NewAzureTranslator(server.URL, "japaneast", "k", retry.NoRetry())
+		_, err := tr.Translate(context.Background(), "hello", "ja")
 		if err == nil {
 			t.Fatal("expected error for empty top-level array")
 		}
 	})
 
+	t.Run("transient server errors are retried", func(t *testing.T) {
+		callCount := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			callCount++
+			if callCount <= 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			_, _ = w.Write([]byte(`[{"translations":[{"text":"こんにちは","to":"ja"}]}]`))
+		}))
+		defer server.Close()
+
+		policy := retry.Policy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: time.Second}
+		tr := NewAzureTranslator(server.URL, "japaneast", "k", policy)
+		got, err := tr.Translate(context.Background(), "hello", "ja")
+		if err != nil {
+			t.Fatalf("Translate failed after retries: %v", err)
+		}
+		if got != "こんにちは" {
+			t.Errorf("expected translation 'こんにちは', got %q", got)
+		}
+		if callCount != 3 {
+			t.Errorf("expected 3 calls, got %d", callCount)
+		}
+	})
+
 	t.Run("network error returns error", func(t *testing.T) {
 		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
 		server.Close() // closed before call so connection is refused
 
-		tr := NewAzureTranslator(server.URL, "japaneast", "k")
-		_, err := tr.Translate("hello", "ja")
+		tr := NewAzureTranslator(server.URL, "japaneast", "k", retry.NoRetry())
+		_, err := tr.Translate(context.Background(), "hello", "ja")
 		if err == nil {
 			t.Fatal("expected error for connection refused")
 		}
 	})
 }
+
+// stubTranslator は受け取ったテキストを記録し、"ja:" を付けて返す Translator です。err が設定されている場合はそのエラーを返します。
+type stubTranslator struct {
+	calls []string
+	err   error
+}
+
+func (s *stubTranslator) Translate(_ context.Context, text, targetLang string) (string, error) {
+	s.calls = append(s.calls, text)
+	if s.err != nil {
+		return "", s.err
+	}
+	return targetLang + ":" + text, nil
+}
+
+func TestTranslateAbstract(t *testing.T) {
+	t.Run("translates the abstract", func(t *testing.T) {
+		stub := &stubTranslator{}
+		got, err := TranslateAbstract(context.Background(), stub, &paper.Paper{Abstract: "hello"}, "ja")
+		if err != nil {
+			t.Fatalf("TranslateAbstract failed: %v", err)
+		}
+		if got != "ja:hello" {
+			t.Errorf("expected 'ja:hello', got %q", got)
+		}
+	})
+
+	t.Run("empty abstract is not sent", func(t *testing.T) {
+		stub := &stubTranslator{}
+		got, err := TranslateAbstract(context.Background(), stub, &paper.Paper{Abstract: "  "}, "ja")
+		if err != nil || got != "" {
+			t.Errorf("expected empty result, got %q (err=%v)", got, err)
+		}
+		if len(stub.calls) != 0 {
+			t.Errorf("expected no translation request, got %v", stub.calls)
+		}
+	})
+}
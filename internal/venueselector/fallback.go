@@ -0,0 +1,60 @@
+package venueselector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+)
+
+var ErrAllVenuesSkipped = errors.New("no venue yielded a paper")
+
+// Skipped は試行したものの論文を得られなかった学会とその理由です。
+type Skipped struct {
+	Venue config.VenueConfig
+	Err   error
+}
+
+// TryVenues は VenueSelector の選定順に学会を試し、try が成功した最初の学会を返します。
+// 2件目以降は、まだ試していない学会の中から同じ VenueSelector で選び直します。
+// maxAttempts 件試しても成功しない場合や、学会を試し尽くした場合は ErrAllVenuesSkipped を返します。
+// 戻り値の []Skipped には成功するまでにスキップした学会が試行順に入ります。
+func TryVenues(ctx context.Context, s VenueSelector, venues []config.VenueConfig, maxAttempts int, try func(venue config.VenueConfig) error) (config.VenueConfig, []Skipped, error) {
+	remaining := append([]config.VenueConfig(nil), venues...)
+	var skipped []Skipped
+
+	for attempt := 1; len(remaining) > 0 && (maxAttempts <= 0 || attempt <= maxAttempts); attempt++ {
+		if err := ctx.Err(); err != nil {
+			return config.VenueConfig{}, skipped, err
+		}
+
+		venue, err := s.Select(remaining)
+		if err != nil {
+			return config.VenueConfig{}, skipped, fmt.Errorf("failed to select venue: %w", err)
+		}
+		remaining = removeVenue(remaining, venue)
+
+		if err := try(venue); err != nil {
+			if ctxErr := ctx.Err(); ctxErr != nil {
+				return config.VenueConfig{}, skipped, ctxErr
+			}
+			skipped = append(skipped, Skipped{Venue: venue, Err: err})
+			continue
+		}
+		return venue, skipped, nil
+	}
+
+	return config.VenueConfig{}, skipped, ErrAllVenuesSkipped
+}
+
+// removeVenue は venues から Venue ID が一致する学会を取り除いたスライスを返します。
+func removeVenue(venues []config.VenueConfig, venue config.VenueConfig) []config.VenueConfig {
+	result := make([]config.VenueConfig, 0, len(venues))
+	for _, v := range venues {
+		if v.Venue != venue.Venue {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,112 @@
+package venueselector
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+)
+
+func TestTryVenues(t *testing.T) {
+	venues := []config.VenueConfig{
+		{Name: "ICLR", Venue: "ICLR.cc/2025/Conference"},
+		{Name: "NeurIPS", Venue: "NeurIPS.cc/2025/Conference"},
+		{Name: "ICML", Venue: "ICML.cc/2025/Conference"},
+	}
+	errEmpty := errors.New("no candidates")
+
+	// round-robin はカーソルがなければ先頭から順に選ぶため、試行順を予測できる
+	newSelector := func(t *testing.T) VenueSelector {
+		return NewRoundRobinVenueSelector(filepath.Join(t.TempDir(), "cursor.json"))
+	}
+
+	t.Run("falls back in selector order until a venue succeeds", func(t *testing.T) {
+		var tried []string
+		venue, skipped, err := TryVenues(context.Background(), newSelector(t), venues, 3, func(v config.VenueConfig) error {
+			tried = append(tried, v.Name)
+			if v.Name == "ICML" {
+				return nil
+			}
+			return errEmpty
+		})
+		if err != nil {
+			t.Fatalf("TryVenues() returned an error: %v", err)
+		}
+		if venue.Name != "ICML" {
+			t.Errorf("expected ICML, got %s", venue.Name)
+		}
+		if len(tried) != 3 || tried[0] != "ICLR" || tried[1] != "NeurIPS" {
+			t.Errorf("unexpected try order: %v", tried)
+		}
+		if len(skipped) != 2 || skipped[0].Venue.Name != "ICLR" || !errors.Is(skipped[0].Err, errEmpty) {
+			t.Errorf("unexpected skipped venues: %+v", skipped)
+		}
+	})
+
+	t.Run("first venue succeeds without fallback", func(t *testing.T) {
+		venue, skipped, err := TryVenues(context.Background(), newSelector(t), venues, 3, func(config.VenueConfig) error { return nil })
+		if err != nil || venue.Name != "ICLR" || len(skipped) != 0 {
+			t.Errorf("unexpected result: venue=%s skipped=%+v err=%v", venue.Name, skipped, err)
+		}
+	})
+
+	t.Run("stops at max attempts", func(t *testing.T) {
+		calls := 0
+		_, skipped, err := TryVenues(context.Background(), newSelector(t), venues, 2, func(config.VenueConfig) error {
+			calls++
+			return errEmpty
+		})
+		if !errors.Is(err, ErrAllVenuesSkipped) {
+			t.Errorf("expected ErrAllVenuesSkipped, got %v", err)
+		}
+		if calls != 2 || len(skipped) != 2 {
+			t.Errorf("expected 2 attempts, got calls=%d skipped=%d", calls, len(skipped))
+		}
+	})
+
+	t.Run("each venue is tried at most once", func(t *testing.T) {
+		calls := 0
+		_, skipped, err := TryVenues(context.Background(), NewRandomVenueSelector(), venues, 10, func(config.VenueConfig) error {
+			calls++
+			return errEmpty
+		})
+		if !errors.Is(err, ErrAllVenuesSkipped) {
+			t.Errorf("expected ErrAllVenuesSkipped, got %v", err)
+		}
+		seen := map[string]bool{}
+		for _, s := range skipped {
+			if seen[s.Venue.Venue] {
+				t.Errorf("venue %s was tried twice", s.Venue.Venue)
+			}
+			seen[s.Venue.Venue] = true
+		}
+		if calls != len(venues) {
+			t.Errorf("expected %d attempts, got %d", len(venues), calls)
+		}
+	})
+
+	t.Run("canceled context stops fallback", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		calls := 0
+		_, _, err := TryVenues(ctx, newSelector(t), venues, 3, func(config.VenueConfig) error {
+			calls++
+			cancel()
+			return ctx.Err()
+		})
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+		if calls != 1 {
+			t.Errorf("expected 1 attempt, got %d", calls)
+		}
+	})
+
+	t.Run("no venues", func(t *testing.T) {
+		_, _, err := TryVenues(context.Background(), newSelector(t), nil, 3, func(config.VenueConfig) error { return nil })
+		if !errors.Is(err, ErrAllVenuesSkipped) {
+			t.Errorf("expected ErrAllVenuesSkipped, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,51 @@
+package venueselector
+
+import (
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+)
+
+// CountFunc は学会の論文数を返す関数です。
+type CountFunc func(venue config.VenueConfig) (int, error)
+
+// PaperCountVenueSelector は論文数に比例した確率で学会を選定します。
+// 論文数を取得できなかった学会は選定対象から外します。全学会で取得できなかった場合は一様ランダムで選びます。
+type PaperCountVenueSelector struct {
+	count CountFunc
+	rand  *rand.Rand
+}
+
+// NewPaperCountVenueSelector は新しいPaperCountVenueSelectorを生成します。
+func NewPaperCountVenueSelector(count CountFunc) VenueSelector {
+	return &PaperCountVenueSelector{
+		count: count,
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Select は学会のリストから論文数に比例した確率で1つを選びます。
+func (s *PaperCountVenueSelector) Select(venues []config.VenueConfig) (config.VenueConfig, error) {
+	if len(venues) == 0 {
+		return config.VenueConfig{}, ErrNoVenues
+	}
+	if s.rand == nil {
+		s.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	weights := make([]float64, len(venues))
+	for i, v := range venues {
+		if s.count == nil {
+			break
+		}
+		n, err := s.count(v)
+		if err != nil {
+			log.Printf("WARN: failed to count papers for %s, excluding it from selection: %v", v.Venue, err)
+			continue
+		}
+		weights[i] = float64(n)
+	}
+	return venues[weightedIndex(s.rand, weights)], nil
+}
@@ -0,0 +1,60 @@
+package venueselector
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+)
+
+func TestPaperCountVenueSelector_Select(t *testing.T) {
+	venues := []config.VenueConfig{
+		{Name: "NeurIPS", Venue: "NeurIPS.cc/2025/Conference"},
+		{Name: "WS", Venue: "WS/2025/Workshop"},
+		{Name: "Broken", Venue: "Broken/2025/Conference"},
+	}
+	counts := map[string]int{"NeurIPS.cc/2025/Conference": 4000, "WS/2025/Workshop": 40}
+	count := func(v config.VenueConfig) (int, error) {
+		if v.Name == "Broken" {
+			return 0, errors.New("api error")
+		}
+		return counts[v.Venue], nil
+	}
+
+	t.Run("selection frequency follows paper count and skips failed venues", func(t *testing.T) {
+		selector := &PaperCountVenueSelector{count: count, rand: rand.New(rand.NewSource(1))}
+
+		selected := map[string]int{}
+		for i := 0; i < 10000; i++ {
+			v, err := selector.Select(venues)
+			if err != nil {
+				t.Fatalf("Select() returned an error: %v", err)
+			}
+			selected[v.Name]++
+		}
+		if selected["Broken"] != 0 {
+			t.Errorf("expected venue with count error never to be selected, got %d", selected["Broken"])
+		}
+		if selected["NeurIPS"] < 9800 {
+			t.Errorf("expected NeurIPS to be selected ~99%% of the time, got %d/10000", selected["NeurIPS"])
+		}
+	})
+
+	t.Run("falls back to uniform when no counts are available", func(t *testing.T) {
+		selector := &PaperCountVenueSelector{
+			count: func(config.VenueConfig) (int, error) { return 0, errors.New("api error") },
+			rand:  rand.New(rand.NewSource(1)),
+		}
+		if _, err := selector.Select(venues); err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+	})
+
+	t.Run("returns error if no venues", func(t *testing.T) {
+		_, err := NewPaperCountVenueSelector(count).Select(nil)
+		if !errors.Is(err, ErrNoVenues) {
+			t.Errorf("expected ErrNoVenues, but got %v", err)
+		}
+	})
+}
@@ -0,0 +1,59 @@
+package venueselector
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+var ErrUnknownStrategy = errors.New("unknown venue select strategy")
+
+// Options は VenueSelector 生成時に渡される実行時の情報です。
+type Options struct {
+	CursorPath  string    // round-robin のカーソルファイル
+	CountPapers CountFunc // paper-count で使う論文数の取得関数
+}
+
+// Factory は Options から VenueSelector を生成する関数です。
+type Factory func(opts Options) VenueSelector
+
+// registry は VENUE_SELECT_STRATEGY の値と VenueSelector の対応表です。
+var registry = map[string]Factory{
+	"random": func(Options) VenueSelector {
+		return NewRandomVenueSelector()
+	},
+	"weighted": func(Options) VenueSelector {
+		return NewWeightedVenueSelector()
+	},
+	"paper-count": func(opts Options) VenueSelector {
+		return NewPaperCountVenueSelector(opts.CountPapers)
+	},
+	"round-robin": func(opts Options) VenueSelector {
+		return NewRoundRobinVenueSelector(opts.CursorPath)
+	},
+}
+
+// New は戦略名に対応する VenueSelector を生成します。
+func New(strategy string, opts Options) (VenueSelector, error) {
+	factory, ok := registry[strategy]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q (available: %v)", ErrUnknownStrategy, strategy, Strategies())
+	}
+	return factory(opts), nil
+}
+
+// IsRegistered は戦略名が登録済みかどうかを返します。
+func IsRegistered(strategy string) bool {
+	_, ok := registry[strategy]
+	return ok
+}
+
+// Strategies は登録済みの戦略名をソートして返します。
+func Strategies() []string {
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
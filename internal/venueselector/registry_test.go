@@ -0,0 +1,37 @@
+package venueselector
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestNew(t *testing.T) {
+	for _, name := range Strategies() {
+		t.Run(name, func(t *testing.T) {
+			s, err := New(name, Options{CursorPath: t.TempDir() + "/cursor.json"})
+			if err != nil {
+				t.Fatalf("New(%q) returned an error: %v", name, err)
+			}
+			if s == nil {
+				t.Fatalf("New(%q) returned nil selector", name)
+			}
+		})
+	}
+
+	t.Run("round-robin commits its selection", func(t *testing.T) {
+		s, _ := New("round-robin", Options{CursorPath: t.TempDir() + "/cursor.json"})
+		if _, ok := s.(Committer); !ok {
+			t.Error("expected round-robin selector to implement Committer")
+		}
+	})
+
+	t.Run("unknown strategy", func(t *testing.T) {
+		if IsRegistered("largest") {
+			t.Error("expected largest not to be registered")
+		}
+		_, err := New("largest", Options{})
+		if !errors.Is(err, ErrUnknownStrategy) {
+			t.Errorf("expected ErrUnknownStrategy, but got %v", err)
+		}
+	})
+}
@@ -0,0 +1,72 @@
+package venueselector
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/atomicfile"
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+)
+
+// cursorFile はラウンドロビンのカーソルファイルの構造です。
+type cursorFile struct {
+	LastVenue string `json:"last_venue"` // 最後に投稿した学会の Venue ID
+}
+
+// RoundRobinVenueSelector は venues.json の並び順に学会を1つずつ選定します。
+// 前回の学会はカーソルファイルに保存され、Commit を呼ぶまで次の学会に進みません。
+type RoundRobinVenueSelector struct {
+	path string
+}
+
+// NewRoundRobinVenueSelector は path のカーソルファイルを使う RoundRobinVenueSelector を生成します。
+func NewRoundRobinVenueSelector(path string) *RoundRobinVenueSelector {
+	return &RoundRobinVenueSelector{path: path}
+}
+
+// Select は前回の学会の次の学会を返します。
+// カーソルファイルがない場合や、前回の学会がリストから削除されている場合は先頭の学会を返します。
+func (s *RoundRobinVenueSelector) Select(venues []config.VenueConfig) (config.VenueConfig, error) {
+	if len(venues) == 0 {
+		return config.VenueConfig{}, ErrNoVenues
+	}
+
+	last, err := s.load()
+	if err != nil {
+		return config.VenueConfig{}, err
+	}
+	for i, v := range venues {
+		if v.Venue == last {
+			return venues[(i+1)%len(venues)], nil
+		}
+	}
+	return venues[0], nil
+}
+
+// Commit は venue を前回の学会としてカーソルファイルに書き出します。
+func (s *RoundRobinVenueSelector) Commit(venue config.VenueConfig) error {
+	if err := atomicfile.WriteJSON(s.path, cursorFile{LastVenue: venue.Venue}); err != nil {
+		return fmt.Errorf("failed to save venue cursor: %w", err)
+	}
+	return nil
+}
+
+// load はカーソルファイルから前回の学会の Venue ID を読み込みます。ファイルがない場合は空文字を返します。
+func (s *RoundRobinVenueSelector) load() (string, error) {
+	bytes, err := os.ReadFile(s.path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read venue cursor file at %s: %w", s.path, err)
+	}
+
+	var file cursorFile
+	if err := json.Unmarshal(bytes, &file); err != nil {
+		return "", fmt.Errorf("failed to parse venue cursor file: %w", err)
+	}
+	return file.LastVenue, nil
+}
@@ -0,0 +1,83 @@
+package venueselector
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+)
+
+func TestRoundRobinVenueSelector(t *testing.T) {
+	venues := []config.VenueConfig{
+		{Name: "ICLR", Venue: "ICLR.cc/2025/Conference"},
+		{Name: "NeurIPS", Venue: "NeurIPS.cc/2025/Conference"},
+		{Name: "ICML", Venue: "ICML.cc/2025/Conference"},
+	}
+
+	t.Run("cycles through venues across runs", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "data", "venue_cursor.json")
+
+		var got []string
+		for i := 0; i < 4; i++ {
+			// 実行ごとに新しいセレクターを作り、カーソルがファイル経由で引き継がれることを確認する
+			selector := NewRoundRobinVenueSelector(path)
+			v, err := selector.Select(venues)
+			if err != nil {
+				t.Fatalf("Select() returned an error: %v", err)
+			}
+			if err := selector.Commit(v); err != nil {
+				t.Fatalf("Commit() returned an error: %v", err)
+			}
+			got = append(got, v.Name)
+		}
+
+		want := []string{"ICLR", "NeurIPS", "ICML", "ICLR"}
+		for i := range want {
+			if got[i] != want[i] {
+				t.Fatalf("expected %v, got %v", want, got)
+			}
+		}
+	})
+
+	t.Run("does not advance without commit", func(t *testing.T) {
+		selector := NewRoundRobinVenueSelector(filepath.Join(t.TempDir(), "venue_cursor.json"))
+		first, _ := selector.Select(venues)
+		second, _ := selector.Select(venues)
+		if first.Name != "ICLR" || second.Name != "ICLR" {
+			t.Errorf("expected ICLR twice, got %s and %s", first.Name, second.Name)
+		}
+	})
+
+	t.Run("restarts from the first venue if the last one was removed", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "venue_cursor.json")
+		if err := os.WriteFile(path, []byte(`{"last_venue":"Removed/2024/Conference"}`), 0644); err != nil {
+			t.Fatal(err)
+		}
+		v, err := NewRoundRobinVenueSelector(path).Select(venues)
+		if err != nil {
+			t.Fatalf("Select() returned an error: %v", err)
+		}
+		if v.Name != "ICLR" {
+			t.Errorf("expected ICLR, got %s", v.Name)
+		}
+	})
+
+	t.Run("corrupted cursor file is an error", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "venue_cursor.json")
+		if err := os.WriteFile(path, []byte(`{`), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := NewRoundRobinVenueSelector(path).Select(venues); err == nil {
+			t.Error("expected error for corrupted cursor file")
+		}
+	})
+
+	t.Run("returns error if no venues", func(t *testing.T) {
+		_, err := NewRoundRobinVenueSelector(filepath.Join(t.TempDir(), "c.json")).Select(nil)
+		if !errors.Is(err, ErrNoVenues) {
+			t.Errorf("expected ErrNoVenues, but got %v", err)
+		}
+	})
+}
@@ -15,6 +15,12 @@ type VenueSelector interface {
 	Select(venues []config.VenueConfig) (config.VenueConfig, error)
 }
 
+// Committer は選定結果を次回の実行に引き継ぐ VenueSelector が実装するインターフェースです。
+// 投稿に成功した後に呼び出します。
+type Committer interface {
+	Commit(venue config.VenueConfig) error
+}
+
 // RandomVenueSelector はランダムに学会を選定します。
 type RandomVenueSelector struct {
 	rand *rand.Rand
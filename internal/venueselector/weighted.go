@@ -0,0 +1,72 @@
+package venueselector
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+)
+
+// WeightedVenueSelector は VenueConfig.Weight に比例した確率で学会を選定します。
+// Weight が未指定 (0) の学会は重み 1 として扱います。
+type WeightedVenueSelector struct {
+	rand *rand.Rand
+}
+
+// NewWeightedVenueSelector は新しいWeightedVenueSelectorを生成します。
+func NewWeightedVenueSelector() VenueSelector {
+	return &WeightedVenueSelector{
+		rand: rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+// Select は学会のリストから重み付きランダムで1つを選びます。
+func (s *WeightedVenueSelector) Select(venues []config.VenueConfig) (config.VenueConfig, error) {
+	if len(venues) == 0 {
+		return config.VenueConfig{}, ErrNoVenues
+	}
+	if s.rand == nil {
+		s.rand = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+
+	weights := make([]float64, len(venues))
+	for i, v := range venues {
+		weights[i] = venueWeight(v)
+	}
+	return venues[weightedIndex(s.rand, weights)], nil
+}
+
+// venueWeight は学会の重みを返します。未指定 (0 以下) の場合は 1 です。
+func venueWeight(v config.VenueConfig) float64 {
+	if v.Weight <= 0 {
+		return 1
+	}
+	return v.Weight
+}
+
+// weightedIndex は weights に比例した確率でインデックスを選びます。
+// 重みの合計が 0 の場合は一様に選びます。weights は空であってはなりません。
+func weightedIndex(r *rand.Rand, weights []float64) int {
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 {
+		return r.Intn(len(weights))
+	}
+
+	x := r.Float64() * total
+	for i, w := range weights {
+		if x < w {
+			return i
+		}
+		x -= w
+	}
+	// 浮動小数点の誤差で末尾を越えた場合は、重みが正の最後の要素を返す
+	for i := len(weights) - 1; i >= 0; i-- {
+		if weights[i] > 0 {
+			return i
+		}
+	}
+	return len(weights) - 1
+}
@@ -0,0 +1,55 @@
+package venueselector
+
+import (
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/hayashi-yaken/daily-paper-bot/internal/config"
+)
+
+func TestWeightedVenueSelector_Select(t *testing.T) {
+	t.Run("selection frequency follows weights", func(t *testing.T) {
+		venues := []config.VenueConfig{
+			{Name: "NeurIPS", Venue: "NeurIPS.cc/2025/Conference", Weight: 9},
+			{Name: "WS", Venue: "WS/2025/Workshop", Weight: 1},
+		}
+		selector := &WeightedVenueSelector{rand: rand.New(rand.NewSource(1))}
+
+		counts := map[string]int{}
+		for i := 0; i < 10000; i++ {
+			v, err := selector.Select(venues)
+			if err != nil {
+				t.Fatalf("Select() returned an error: %v", err)
+			}
+			counts[v.Name]++
+		}
+		if counts["NeurIPS"] < 8500 || counts["NeurIPS"] > 9500 {
+			t.Errorf("expected NeurIPS to be selected ~90%% of the time, got %d/10000", counts["NeurIPS"])
+		}
+	})
+
+	t.Run("unset weight counts as 1", func(t *testing.T) {
+		venues := []config.VenueConfig{
+			{Name: "A", Venue: "A"},
+			{Name: "B", Venue: "B", Weight: 1},
+		}
+		selector := &WeightedVenueSelector{rand: rand.New(rand.NewSource(1))}
+
+		counts := map[string]int{}
+		for i := 0; i < 10000; i++ {
+			v, _ := selector.Select(venues)
+			counts[v.Name]++
+		}
+		if counts["A"] < 4500 || counts["A"] > 5500 {
+			t.Errorf("expected A to be selected ~50%% of the time, got %d/10000", counts["A"])
+		}
+	})
+
+	t.Run("returns error if no venues", func(t *testing.T) {
+		_, err := NewWeightedVenueSelector().Select(nil)
+		if !errors.Is(err, ErrNoVenues) {
+			t.Errorf("expected ErrNoVenues, but got %v", err)
+		}
+	})
+}